@@ -0,0 +1,110 @@
+package artifact
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/api"
+	"github.com/goharbor/harbor-cli/pkg/prompt"
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	"github.com/goharbor/harbor-cli/pkg/views/artifact/accessories"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func ArtifactAccessoriesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accessories",
+		Short: "List accessories of an artifact",
+		Long:  `List accessories of an artifact such as cosign and notation signatures, SBOMs and attestations`,
+		Example: `  harbor artifact accessories <project>/<repository>/<reference>
+  harbor artifact accessories delete <project>/<repository>/<reference> <accessory digest>`,
+		Args: cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			var err error
+			var list []*models.Accessory
+			var projectName, repoName, reference string
+
+			if len(args) > 0 {
+				projectName, repoName, reference = utils.ParseProjectRepoReference(args[0])
+			} else {
+				projectName = prompt.GetProjectNameFromUser()
+				repoName = prompt.GetRepoNameFromUser(projectName)
+				reference = prompt.GetReferenceFromUser(repoName, projectName)
+			}
+
+			list, err = api.ListAccessories(projectName, repoName, reference)
+			if err != nil {
+				log.Errorf("failed to list accessories: %v", err)
+				return
+			}
+
+			FormatFlag := viper.GetString("output-format")
+			if FormatFlag != "" {
+				err = utils.PrintFormat(list, FormatFlag)
+				if err != nil {
+					log.Error(err)
+				}
+			} else {
+				accessories.ListAccessories(list)
+			}
+		},
+	}
+
+	cmd.AddCommand(
+		DeleteAccessoryCmd(),
+	)
+
+	return cmd
+}
+
+func DeleteAccessoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delete",
+		Short:   "Delete an accessory of an artifact",
+		Long:    `Delete an accessory of an artifact. The accessory can be given by its full digest or an unambiguous prefix of it.`,
+		Example: `harbor artifact accessories delete <project>/<repository>/<reference> <accessory digest>`,
+		Args:    cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			projectName, repoName, reference := utils.ParseProjectRepoReference(args[0])
+
+			list, err := api.ListAccessories(projectName, repoName, reference)
+			if err != nil {
+				log.Errorf("failed to list accessories: %v", err)
+				return
+			}
+
+			accessory, err := findAccessory(list, args[1])
+			if err != nil {
+				log.Errorf("failed to delete accessory: %v", err)
+				return
+			}
+
+			err = api.DeleteArtifact(projectName, repoName, accessory.Digest)
+			if err != nil {
+				log.Errorf("failed to delete accessory: %v", err)
+			}
+		},
+	}
+
+	return cmd
+}
+
+func findAccessory(list []*models.Accessory, digest string) (*models.Accessory, error) {
+	var found *models.Accessory
+	for _, accessory := range list {
+		if !strings.HasPrefix(accessory.Digest, digest) {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("digest %s matches more than one accessory", digest)
+		}
+		found = accessory
+	}
+	if found == nil {
+		return nil, fmt.Errorf("no accessory with digest %s", digest)
+	}
+	return found, nil
+}
@@ -0,0 +1,118 @@
+package artifact
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/goharbor/harbor-cli/pkg/api"
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	"github.com/goharbor/harbor-cli/pkg/views/artifact/additions"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	additionBuildHistory = "build_history"
+	additionReadme       = "readme"
+	additionValues       = "values"
+	additionDependencies = "dependencies"
+)
+
+var artifactAdditions = []string{additionBuildHistory, additionReadme, additionValues, additionDependencies}
+
+func ArtifactAdditionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "additions",
+		Short: "Show additions of an artifact",
+		Long: `Show additions of an artifact: the build history of an image, or the README,
+values and dependencies of a Helm chart`,
+		Example: `  harbor artifact additions <project>/<repository>/<reference> build_history
+  harbor artifact additions <project>/<repository>/<reference> readme`,
+		Args: cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			addition := args[1]
+
+			if !isArtifactAddition(addition) {
+				log.Errorf("unknown addition %q, must be one of %v", addition, artifactAdditions)
+				return
+			}
+
+			projectName, repoName, reference := utils.ParseProjectRepoReference(args[0])
+			content, err := api.GetAddition(projectName, repoName, reference, addition)
+			if err != nil {
+				log.Errorf("failed to get %s: %v", addition, err)
+				return
+			}
+
+			err = viewAddition(addition, content, viper.GetString("output-format"))
+			if err != nil {
+				log.Errorf("failed to show %s: %v", addition, err)
+			}
+		},
+	}
+
+	return cmd
+}
+
+func isArtifactAddition(addition string) bool {
+	for _, a := range artifactAdditions {
+		if a == addition {
+			return true
+		}
+	}
+	return false
+}
+
+// viewAddition shows an addition. With an output format the JSON additions
+// are printed as received rather than through the types used for display.
+func viewAddition(addition string, content []byte, format string) error {
+	switch addition {
+	case additionBuildHistory:
+		if format != "" {
+			return printJSONAddition(content, format)
+		}
+		var history []additions.BuildHistoryEntry
+		if err := json.Unmarshal(content, &history); err != nil {
+			return fmt.Errorf("failed to parse build history: %v", err)
+		}
+		additions.ViewBuildHistory(history)
+	case additionDependencies:
+		if format != "" {
+			return printJSONAddition(content, format)
+		}
+		var dependencies []additions.Dependency
+		if err := json.Unmarshal(content, &dependencies); err != nil {
+			return fmt.Errorf("failed to parse dependencies: %v", err)
+		}
+		additions.ViewDependencies(dependencies)
+	case additionReadme:
+		if format != "" {
+			return utils.PrintFormat(string(content), format)
+		}
+		additions.ViewMarkdown(string(content))
+	case additionValues:
+		if format != "" {
+			return utils.PrintFormat(string(content), format)
+		}
+		additions.ViewValues(string(content))
+	}
+	return nil
+}
+
+func printJSONAddition(content []byte, format string) error {
+	if format == "json" {
+		if !bytes.HasSuffix(content, []byte("\n")) {
+			content = append(content, '\n')
+		}
+		_, err := os.Stdout.Write(content)
+		return err
+	}
+	var value interface{}
+	if err := json.Unmarshal(content, &value); err != nil {
+		return fmt.Errorf("failed to parse addition: %v", err)
+	}
+	return utils.PrintFormat(value, format)
+}
@@ -19,6 +19,15 @@ func Artifact() *cobra.Command {
 		DeleteArtifactCommand(),
 		ScanArtifactCommand(),
 		ArtifactTagsCmd(),
+		CopyArtifactCommand(),
+		ArtifactLabelsCmd(),
+		PruneArtifactCommand(),
+		ArtifactAccessoriesCmd(),
+		ArtifactAdditionsCmd(),
+		DiffArtifactCommand(),
+		ExportArtifactCommand(),
+		ImportArtifactCommand(),
+		VerifyArtifactCommand(),
 	)
 
 	return cmd
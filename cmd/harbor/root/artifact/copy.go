@@ -0,0 +1,183 @@
+package artifact
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/goharbor/harbor-cli/pkg/api"
+	"github.com/goharbor/harbor-cli/pkg/prompt"
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+type copyOptions struct {
+	tags     []string
+	allTags  bool
+	fromFile string
+}
+
+func CopyArtifactCommand() *cobra.Command {
+	var opts copyOptions
+
+	cmd := &cobra.Command{
+		Use:   "copy",
+		Short: "Copy an artifact to another project or repository",
+		Long: `Copy an artifact to another project or repository using the Harbor copy artifact API.
+The destination repository is created if it does not exist. Use --from-file to promote
+several artifacts at once, the file holds one "<project>/<repository>/<reference> <project>/<repository>"
+pair per line, blank lines and lines starting with '#' are ignored.`,
+		Example: `  harbor artifact copy staging/app/v1.5 prod/app
+  harbor artifact copy staging/app/v1.5 prod/app --tag latest --tag stable
+  harbor artifact copy staging/app/sha256:3f2c... prod/app --all-tags
+  harbor artifact copy --from-file promote.txt`,
+		Args: cobra.MaximumNArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			if opts.fromFile != "" {
+				if len(args) > 0 {
+					log.Errorf("arguments cannot be used together with --from-file")
+					return
+				}
+				if err := copyArtifactsFromFile(opts); err != nil {
+					log.Errorf("failed to copy artifacts: %v", err)
+				}
+				return
+			}
+
+			var srcProject, srcRepo, reference, dstProject, dstRepo string
+			switch len(args) {
+			case 2:
+				srcProject, srcRepo, reference = utils.ParseProjectRepoReference(args[0])
+				dstProject, dstRepo = utils.ParseProjectRepo(args[1])
+			case 0:
+				srcProject = prompt.GetProjectNameFromUser()
+				srcRepo = prompt.GetRepoNameFromUser(srcProject)
+				reference = prompt.GetReferenceFromUser(srcRepo, srcProject)
+				dstProject = prompt.GetProjectNameFromUser()
+				dstRepo = srcRepo
+			default:
+				log.Errorf("both the source artifact and the destination repository are required")
+				return
+			}
+
+			err := copyArtifact(srcProject, srcRepo, reference, dstProject, dstRepo, opts)
+			if err != nil {
+				log.Errorf("failed to copy artifact: %v", err)
+			}
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringSliceVarP(&opts.tags, "tag", "t", nil, "Additional tag to create on the copied artifact, can be repeated")
+	flags.BoolVar(&opts.allTags, "all-tags", false, "Recreate every tag of the source artifact on the destination")
+	flags.StringVarP(&opts.fromFile, "from-file", "f", "", "File listing the artifacts to copy, one \"<source> <destination>\" pair per line")
+
+	return cmd
+}
+
+func copyArtifact(srcProject, srcRepo, reference, dstProject, dstRepo string, opts copyOptions) error {
+	src, err := api.ViewArtifact(srcProject, srcRepo, reference)
+	if err != nil {
+		return err
+	}
+
+	from := utils.FormatArtifactReference(srcProject, srcRepo, reference)
+	if err := api.CopyArtifact(from, dstProject, dstRepo); err != nil {
+		return err
+	}
+
+	// Copying by tag already carries that tag over to the destination.
+	existing := map[string]bool{}
+	if !utils.IsDigest(reference) {
+		existing[reference] = true
+	}
+
+	tags := opts.tags
+	if opts.allTags {
+		for _, tag := range src.Payload.Tags {
+			tags = append(tags, tag.Name)
+		}
+	}
+
+	for _, tag := range tags {
+		if existing[tag] {
+			continue
+		}
+		existing[tag] = true
+		if err := api.CreateTag(dstProject, dstRepo, src.Payload.Digest, tag); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func copyArtifactsFromFile(opts copyOptions) error {
+	file, err := os.Open(opts.fromFile)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %v", opts.fromFile, err)
+	}
+	defer file.Close()
+
+	var copied, failed int
+	scanner := bufio.NewScanner(file)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		entry, err := parseCopyLine(line)
+		if err != nil {
+			log.Errorf("line %d: %v", lineNumber, err)
+			failed++
+			continue
+		}
+
+		if err := copyArtifact(entry.srcProject, entry.srcRepo, entry.reference, entry.dstProject, entry.dstRepo, opts); err != nil {
+			log.Errorf("line %d: %v", lineNumber, err)
+			failed++
+			continue
+		}
+		copied++
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read %s: %v", opts.fromFile, err)
+	}
+
+	fmt.Printf("%d artifact(s) copied, %d failed\n", copied, failed)
+	if failed > 0 {
+		return fmt.Errorf("%d artifact(s) could not be copied", failed)
+	}
+	return nil
+}
+
+type copyEntry struct {
+	srcProject, srcRepo, reference string
+	dstProject, dstRepo            string
+}
+
+// parseCopyLine parses a "<project>/<repository>/<reference> <project>/<repository>"
+// line of a --from-file list.
+func parseCopyLine(line string) (copyEntry, error) {
+	fields := strings.Fields(line)
+	if len(fields) == 2 {
+		src := strings.Split(fields[0], "/")
+		dst := strings.Split(fields[1], "/")
+		if len(src) == 3 && len(dst) == 2 && !slices.Contains(src, "") && !slices.Contains(dst, "") {
+			return copyEntry{
+				srcProject: src[0],
+				srcRepo:    src[1],
+				reference:  src[2],
+				dstProject: dst[0],
+				dstRepo:    dst[1],
+			}, nil
+		}
+	}
+	return copyEntry{}, fmt.Errorf("expected \"<project>/<repository>/<reference> <project>/<repository>\", got %q", line)
+}
@@ -0,0 +1,42 @@
+package artifact
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCopyLine(t *testing.T) {
+	tests := []struct {
+		line    string
+		want    copyEntry
+		wantErr bool
+	}{
+		{
+			line: "dev/app/v1.2.0 prod/app",
+			want: copyEntry{srcProject: "dev", srcRepo: "app", reference: "v1.2.0", dstProject: "prod", dstRepo: "app"},
+		},
+		{
+			line: "  dev/app/sha256:abc   prod/app-mirror ",
+			want: copyEntry{srcProject: "dev", srcRepo: "app", reference: "sha256:abc", dstProject: "prod", dstRepo: "app-mirror"},
+		},
+		{line: "dev/app/v1 prod/app extra", wantErr: true},
+		{line: "dev/app prod/app", wantErr: true},
+		{line: "dev/app/v1 prod", wantErr: true},
+		{line: "dev//v1 prod/app", wantErr: true},
+		{line: "dev/app/v1 prod/", wantErr: true},
+		{line: "dev/app/v1/extra prod/app", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.line, func(t *testing.T) {
+			got, err := parseCopyLine(tt.line)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -9,20 +9,29 @@ import (
 )
 
 func DeleteArtifactCommand() *cobra.Command {
+	var platform string
+
 	cmd := &cobra.Command{
 		Use:   "delete",
 		Short: "delete an artifact",
-		Args:  cobra.MaximumNArgs(1),
+		Long: `Delete an artifact. Deleting an image index also removes the platform manifests
+it references unless they are tagged or referenced elsewhere. Use --platform to delete
+only the manifest of one platform; Harbor refuses this while the index still references it.`,
+		Args: cobra.MaximumNArgs(1),
 		Run: func(cmd *cobra.Command, args []string) {
 			var err error
+			var projectName, repoName, reference string
 
 			if len(args) > 0 {
-				projectName, repoName, reference := utils.ParseProjectRepoReference(args[0])
-				err = api.DeleteArtifact(projectName, repoName, reference)
+				projectName, repoName, reference = utils.ParseProjectRepoReference(args[0])
 			} else {
-				projectName := prompt.GetProjectNameFromUser()
-				repoName := prompt.GetRepoNameFromUser(projectName)
-				reference := prompt.GetReferenceFromUser(repoName, projectName)
+				projectName = prompt.GetProjectNameFromUser()
+				repoName = prompt.GetRepoNameFromUser(projectName)
+				reference = prompt.GetReferenceFromUser(repoName, projectName)
+			}
+
+			reference, err = resolvePlatform(projectName, repoName, reference, platform)
+			if err == nil {
 				err = api.DeleteArtifact(projectName, repoName, reference)
 			}
 
@@ -32,5 +41,7 @@ func DeleteArtifactCommand() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().StringVar(&platform, "platform", "", "Delete only the manifest of the given platform of an image index, e.g. linux/arm64")
+
 	return cmd
 }
@@ -0,0 +1,347 @@
+package artifact
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/api"
+	"github.com/goharbor/harbor-cli/pkg/oci"
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	"github.com/goharbor/harbor-cli/pkg/views/artifact/additions"
+	"github.com/goharbor/harbor-cli/pkg/views/artifact/diff"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func DiffArtifactCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Compare two artifacts",
+		Long: `Compare two artifacts: their manifests, layers, image configuration, build history
+and, when both are scanned, the vulnerabilities added, removed and fixed between them`,
+		Example: `  harbor artifact diff library/app/1.4 library/app/1.5
+  harbor artifact diff library/app/1.4 library/app/1.5 -o json`,
+		Args: cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			from, err := getArtifactSnapshot(args[0])
+			if err != nil {
+				log.Errorf("failed to get %s: %v", args[0], err)
+				return
+			}
+			to, err := getArtifactSnapshot(args[1])
+			if err != nil {
+				log.Errorf("failed to get %s: %v", args[1], err)
+				return
+			}
+
+			report := diffArtifacts(from, to)
+
+			FormatFlag := viper.GetString("output-format")
+			if FormatFlag != "" {
+				err = utils.PrintFormat(report, FormatFlag)
+				if err != nil {
+					log.Error(err)
+				}
+			} else {
+				diff.ViewReport(report)
+			}
+		},
+	}
+
+	return cmd
+}
+
+// artifactSnapshot gathers everything compared by the diff for one artifact.
+type artifactSnapshot struct {
+	reference string
+	artifact  *models.Artifact
+	manifest  *oci.Manifest
+	config    *oci.ImageConfig
+	history   []additions.BuildHistoryEntry
+	vulns     api.VulnerabilityReport
+	scanned   bool
+}
+
+func getArtifactSnapshot(ref string) (*artifactSnapshot, error) {
+	projectName, repoName, reference := utils.ParseProjectRepoReference(ref)
+	snapshot := &artifactSnapshot{reference: ref}
+
+	response, err := api.ViewArtifact(projectName, repoName, reference)
+	if err != nil {
+		return nil, err
+	}
+	snapshot.artifact = response.Payload
+
+	client, err := oci.NewClientFromCurrentCredential()
+	if err != nil {
+		return nil, err
+	}
+	ctx := context.Background()
+	repository := projectName + "/" + repoName
+
+	_, content, err := client.FetchManifest(ctx, repository, snapshot.artifact.Digest)
+	if err != nil {
+		return nil, err
+	}
+	snapshot.manifest, err = oci.ParseManifest(content)
+	if err != nil {
+		return nil, err
+	}
+
+	if snapshot.manifest.Config != nil && !snapshot.manifest.IsIndex() {
+		content, err = client.FetchBlob(ctx, repository, snapshot.manifest.Config.Digest)
+		if err != nil {
+			return nil, err
+		}
+		var config oci.ImageConfig
+		if err := json.Unmarshal(content, &config); err == nil {
+			snapshot.config = &config
+		}
+	}
+
+	// Only images carry a build history, other artifacts are compared without it.
+	if snapshot.artifact.Type == "IMAGE" && !snapshot.manifest.IsIndex() {
+		history, err := api.GetAddition(projectName, repoName, snapshot.artifact.Digest, additionBuildHistory)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(history, &snapshot.history); err != nil {
+			return nil, fmt.Errorf("failed to parse build history of %s: %v", ref, err)
+		}
+	}
+
+	snapshot.vulns, snapshot.scanned, err = api.GetVulnerabilities(projectName, repoName, snapshot.artifact.Digest)
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+func diffArtifacts(from, to *artifactSnapshot) diff.Report {
+	report := diff.Report{
+		From: diff.Side{
+			Reference: from.reference,
+			Digest:    from.artifact.Digest,
+			MediaType: from.artifact.ManifestMediaType,
+			Size:      from.artifact.Size,
+		},
+		To: diff.Side{
+			Reference: to.reference,
+			Digest:    to.artifact.Digest,
+			MediaType: to.artifact.ManifestMediaType,
+			Size:      to.artifact.Size,
+		},
+	}
+
+	report.Manifests = diffChildManifests(from.manifest.Manifests, to.manifest.Manifests)
+	report.Layers = diffLayers(from.manifest.Layers, to.manifest.Layers)
+	report.Config = diffConfig(from.config, to.config)
+	report.History = diffHistory(from.history, to.history)
+	if from.scanned && to.scanned {
+		report.Vulnerabilities = diffVulnerabilities(from.vulns.Vulnerabilities, to.vulns.Vulnerabilities)
+	}
+
+	return report
+}
+
+func diffChildManifests(from, to []oci.Descriptor) []diff.Change {
+	platform := func(d oci.Descriptor) string {
+		if d.Platform == nil {
+			return "unknown"
+		}
+		return utils.FormatPlatform(d.Platform.OS, d.Platform.Architecture, d.Platform.Variant)
+	}
+
+	fromDigests := map[string]string{}
+	for _, d := range from {
+		fromDigests[platform(d)] = d.Digest
+	}
+	toDigests := map[string]string{}
+	for _, d := range to {
+		toDigests[platform(d)] = d.Digest
+	}
+
+	return diffMaps("Platform ", fromDigests, toDigests)
+}
+
+func diffLayers(from, to []oci.Descriptor) diff.LayerDiff {
+	result := diff.LayerDiff{Added: []diff.Layer{}, Removed: []diff.Layer{}}
+
+	fromDigests := map[string]bool{}
+	for _, l := range from {
+		fromDigests[l.Digest] = true
+	}
+	toDigests := map[string]bool{}
+	for _, l := range to {
+		toDigests[l.Digest] = true
+		if fromDigests[l.Digest] {
+			result.Unchanged++
+		} else {
+			result.Added = append(result.Added, diff.Layer{Digest: l.Digest, Size: l.Size})
+		}
+	}
+	for _, l := range from {
+		if !toDigests[l.Digest] {
+			result.Removed = append(result.Removed, diff.Layer{Digest: l.Digest, Size: l.Size})
+		}
+	}
+
+	return result
+}
+
+func diffConfig(from, to *oci.ImageConfig) []diff.Change {
+	if from == nil {
+		from = &oci.ImageConfig{}
+	}
+	if to == nil {
+		to = &oci.ImageConfig{}
+	}
+
+	changes := []diff.Change{}
+	field := func(name, a, b string) {
+		if a != b {
+			changes = append(changes, diff.Change{Field: name, From: a, To: b})
+		}
+	}
+	list := func(values []string) string {
+		if len(values) == 0 {
+			return ""
+		}
+		return "[" + strings.Join(values, " ") + "]"
+	}
+
+	field("Platform", platformOf(from), platformOf(to))
+	field("User", from.Config.User, to.Config.User)
+	field("WorkingDir", from.Config.WorkingDir, to.Config.WorkingDir)
+	field("Entrypoint", list(from.Config.Entrypoint), list(to.Config.Entrypoint))
+	field("Cmd", list(from.Config.Cmd), list(to.Config.Cmd))
+	changes = append(changes, diffMaps("Env ", envMap(from.Config.Env), envMap(to.Config.Env))...)
+	changes = append(changes, diffMaps("Label ", from.Config.Labels, to.Config.Labels)...)
+	changes = append(changes, diffMaps("Port ", portMap(from.Config.ExposedPorts), portMap(to.Config.ExposedPorts))...)
+
+	return changes
+}
+
+func platformOf(config *oci.ImageConfig) string {
+	if config.OS == "" && config.Architecture == "" {
+		return ""
+	}
+	return utils.FormatPlatform(config.OS, config.Architecture, config.Variant)
+}
+
+func envMap(env []string) map[string]string {
+	m := map[string]string{}
+	for _, e := range env {
+		k, v, _ := strings.Cut(e, "=")
+		m[k] = v
+	}
+	return m
+}
+
+func portMap(ports map[string]struct{}) map[string]string {
+	m := map[string]string{}
+	for port := range ports {
+		m[port] = "exposed"
+	}
+	return m
+}
+
+// diffMaps returns the added, removed and changed keys sorted by key.
+func diffMaps(prefix string, from, to map[string]string) []diff.Change {
+	keys := map[string]bool{}
+	for k := range from {
+		keys[k] = true
+	}
+	for k := range to {
+		keys[k] = true
+	}
+	sorted := make([]string, 0, len(keys))
+	for k := range keys {
+		sorted = append(sorted, k)
+	}
+	sort.Strings(sorted)
+
+	var changes []diff.Change
+	for _, k := range sorted {
+		a, inFrom := from[k]
+		b, inTo := to[k]
+		switch {
+		case inFrom && !inTo:
+			changes = append(changes, diff.Change{Field: prefix + k, From: a})
+		case !inFrom && inTo:
+			changes = append(changes, diff.Change{Field: prefix + k, To: b})
+		case a != b:
+			changes = append(changes, diff.Change{Field: prefix + k, From: a, To: b})
+		}
+	}
+	return changes
+}
+
+// diffHistory compares build steps in order: steps after the first
+// divergence are reported as removed from the old and added in the new.
+func diffHistory(from, to []additions.BuildHistoryEntry) diff.HistoryDiff {
+	result := diff.HistoryDiff{Added: []string{}, Removed: []string{}}
+
+	common := 0
+	for common < len(from) && common < len(to) && from[common].CreatedBy == to[common].CreatedBy {
+		common++
+	}
+	for _, step := range from[common:] {
+		result.Removed = append(result.Removed, strings.Join(strings.Fields(step.CreatedBy), " "))
+	}
+	for _, step := range to[common:] {
+		result.Added = append(result.Added, strings.Join(strings.Fields(step.CreatedBy), " "))
+	}
+
+	return result
+}
+
+// diffVulnerabilities reports vulnerabilities only found in the new artifact
+// as added. Those only found in the old one are fixed when the package is
+// still present and removed otherwise.
+func diffVulnerabilities(from, to []api.Vulnerability) *diff.VulnerabilityDiff {
+	result := &diff.VulnerabilityDiff{
+		Added:   []api.Vulnerability{},
+		Removed: []api.Vulnerability{},
+		Fixed:   []api.Vulnerability{},
+	}
+
+	key := func(v api.Vulnerability) string {
+		return v.ID + "|" + v.Package
+	}
+
+	fromKeys := map[string]bool{}
+	for _, v := range from {
+		fromKeys[key(v)] = true
+	}
+	toKeys := map[string]bool{}
+	toPackages := map[string]bool{}
+	for _, v := range to {
+		toKeys[key(v)] = true
+		toPackages[v.Package] = true
+	}
+
+	for _, v := range to {
+		if !fromKeys[key(v)] {
+			result.Added = append(result.Added, v)
+		}
+	}
+	for _, v := range from {
+		if toKeys[key(v)] {
+			continue
+		}
+		if toPackages[v.Package] {
+			result.Fixed = append(result.Fixed, v)
+		} else {
+			result.Removed = append(result.Removed, v)
+		}
+	}
+
+	return result
+}
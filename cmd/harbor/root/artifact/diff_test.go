@@ -0,0 +1,118 @@
+package artifact
+
+import (
+	"testing"
+
+	"github.com/goharbor/harbor-cli/pkg/api"
+	"github.com/goharbor/harbor-cli/pkg/oci"
+	"github.com/goharbor/harbor-cli/pkg/views/artifact/additions"
+	"github.com/goharbor/harbor-cli/pkg/views/artifact/diff"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffLayers(t *testing.T) {
+	from := []oci.Descriptor{{Digest: "sha256:base", Size: 10}, {Digest: "sha256:app-1", Size: 5}}
+	to := []oci.Descriptor{{Digest: "sha256:base", Size: 10}, {Digest: "sha256:app-2", Size: 6}}
+
+	assert.Equal(t, diff.LayerDiff{
+		Added:     []diff.Layer{{Digest: "sha256:app-2", Size: 6}},
+		Removed:   []diff.Layer{{Digest: "sha256:app-1", Size: 5}},
+		Unchanged: 1,
+	}, diffLayers(from, to))
+}
+
+func TestDiffChildManifests(t *testing.T) {
+	from := []oci.Descriptor{
+		{Digest: "sha256:amd64-1", Platform: &oci.Platform{OS: "linux", Architecture: "amd64"}},
+		{Digest: "sha256:arm64", Platform: &oci.Platform{OS: "linux", Architecture: "arm64", Variant: "v8"}},
+	}
+	to := []oci.Descriptor{
+		{Digest: "sha256:amd64-2", Platform: &oci.Platform{OS: "linux", Architecture: "amd64"}},
+		{Digest: "sha256:s390x", Platform: &oci.Platform{OS: "linux", Architecture: "s390x"}},
+	}
+
+	assert.Equal(t, []diff.Change{
+		{Field: "Platform linux/amd64", From: "sha256:amd64-1", To: "sha256:amd64-2"},
+		{Field: "Platform linux/arm64/v8", From: "sha256:arm64"},
+		{Field: "Platform linux/s390x", To: "sha256:s390x"},
+	}, diffChildManifests(from, to))
+}
+
+func TestDiffConfig(t *testing.T) {
+	from := &oci.ImageConfig{OS: "linux", Architecture: "amd64", Config: oci.ContainerConfig{
+		User: "app",
+		Env:  []string{"PATH=/usr/bin", "VERSION=1"},
+		Cmd:  []string{"serve"},
+	}}
+	to := &oci.ImageConfig{OS: "linux", Architecture: "amd64", Config: oci.ContainerConfig{
+		User:   "app",
+		Env:    []string{"PATH=/usr/bin", "VERSION=2", "DEBUG=false"},
+		Cmd:    []string{"serve", "--verbose"},
+		Labels: map[string]string{"maintainer": "team"},
+	}}
+
+	assert.Equal(t, []diff.Change{
+		{Field: "Cmd", From: "[serve]", To: "[serve --verbose]"},
+		{Field: "Env DEBUG", To: "false"},
+		{Field: "Env VERSION", From: "1", To: "2"},
+		{Field: "Label maintainer", To: "team"},
+	}, diffConfig(from, to))
+	assert.Equal(t, []diff.Change{}, diffConfig(nil, nil))
+}
+
+func TestDiffHistory(t *testing.T) {
+	step := func(createdBy string) additions.BuildHistoryEntry {
+		return additions.BuildHistoryEntry{CreatedBy: createdBy}
+	}
+	tests := []struct {
+		name     string
+		from, to []additions.BuildHistoryEntry
+		want     diff.HistoryDiff
+	}{
+		{
+			name: "identical",
+			from: []additions.BuildHistoryEntry{step("ADD base"), step("RUN make")},
+			to:   []additions.BuildHistoryEntry{step("ADD base"), step("RUN make")},
+			want: diff.HistoryDiff{Added: []string{}, Removed: []string{}},
+		},
+		{
+			name: "steps after the first divergence",
+			from: []additions.BuildHistoryEntry{step("ADD base"), step("RUN make"), step("CMD serve")},
+			to:   []additions.BuildHistoryEntry{step("ADD base"), step("RUN  make   test"), step("CMD serve")},
+			want: diff.HistoryDiff{
+				Added:   []string{"RUN make test", "CMD serve"},
+				Removed: []string{"RUN make", "CMD serve"},
+			},
+		},
+		{
+			name: "appended step",
+			from: []additions.BuildHistoryEntry{step("ADD base")},
+			to:   []additions.BuildHistoryEntry{step("ADD base"), step("USER app")},
+			want: diff.HistoryDiff{Added: []string{"USER app"}, Removed: []string{}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, diffHistory(tt.from, tt.to))
+		})
+	}
+}
+
+func TestDiffVulnerabilities(t *testing.T) {
+	from := []api.Vulnerability{
+		{ID: "CVE-1", Package: "openssl"},
+		{ID: "CVE-2", Package: "curl"},
+		{ID: "CVE-3", Package: "zlib"},
+	}
+	to := []api.Vulnerability{
+		{ID: "CVE-1", Package: "openssl"},
+		{ID: "CVE-4", Package: "curl"},
+	}
+
+	assert.Equal(t, &diff.VulnerabilityDiff{
+		Added:   []api.Vulnerability{{ID: "CVE-4", Package: "curl"}},
+		Removed: []api.Vulnerability{{ID: "CVE-3", Package: "zlib"}},
+		Fixed:   []api.Vulnerability{{ID: "CVE-2", Package: "curl"}},
+	}, diffVulnerabilities(from, to))
+}
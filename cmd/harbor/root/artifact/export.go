@@ -0,0 +1,113 @@
+package artifact
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/goharbor/harbor-cli/pkg/oci"
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+type exportOptions struct {
+	layoutDir string
+	tarball   string
+	platform  string
+	workers   int
+}
+
+func ExportArtifactCommand() *cobra.Command {
+	var opts exportOptions
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export an artifact to an OCI image layout or tarball",
+		Long: `Export an artifact with its manifests, config and layers to an OCI image layout
+directory or a tarball of it, for transfer to air-gapped registries. Blobs are verified
+against their digest and interrupted downloads are resumed when the export is run again.`,
+		Example: `  harbor artifact export library/nginx/1.27 --oci-layout ./nginx
+  harbor artifact export library/nginx/1.27 --tarball nginx.tar --platform linux/amd64`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			if opts.layoutDir == "" && opts.tarball == "" {
+				log.Errorf("one of --oci-layout or --tarball is required")
+				return
+			}
+
+			err := exportArtifact(args[0], opts)
+			if err != nil {
+				log.Errorf("failed to export artifact: %v", err)
+			}
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&opts.layoutDir, "oci-layout", "", "Directory of the OCI image layout to write")
+	flags.StringVar(&opts.tarball, "tarball", "", "Write the OCI image layout as a tar archive to this file")
+	flags.StringVar(&opts.platform, "platform", "", "Export only the manifest of the given platform of an image index, e.g. linux/amd64")
+	flags.IntVar(&opts.workers, "workers", 4, "Number of concurrent blob downloads")
+
+	return cmd
+}
+
+func exportArtifact(ref string, opts exportOptions) error {
+	projectName, repoName, reference := utils.ParseProjectRepoReference(ref)
+
+	// Without a layout directory the tarball is staged next to it, so an
+	// interrupted export can resume.
+	layoutDir := opts.layoutDir
+	staged := false
+	if layoutDir == "" {
+		layoutDir = opts.tarball + ".layout"
+		staged = true
+	}
+
+	layout, err := oci.NewLayout(layoutDir)
+	if err != nil {
+		return err
+	}
+	client, err := oci.NewClientFromCurrentCredential()
+	if err != nil {
+		return err
+	}
+
+	refName := ""
+	if !utils.IsDigest(reference) {
+		refName = reference
+	}
+
+	result, err := oci.Export(context.Background(), client, layout, projectName+"/"+repoName, reference, oci.ExportOptions{
+		Platform: opts.platform,
+		RefName:  refName,
+		Workers:  opts.workers,
+	})
+	if err != nil {
+		return err
+	}
+	log.Infof("Exported %s (%s): %d blobs, %s downloaded, %d already present",
+		ref, result.Descriptor.Digest, result.Blobs, utils.FormatSize(result.Downloaded), result.Reused)
+
+	if opts.tarball == "" {
+		return nil
+	}
+
+	file, err := os.Create(opts.tarball)
+	if err != nil {
+		return err
+	}
+	if err := layout.WriteTar(file); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to write %s: %v", opts.tarball, err)
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	log.Infof("Wrote %s", opts.tarball)
+
+	if staged {
+		return os.RemoveAll(layoutDir)
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package artifact
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/goharbor/harbor-cli/pkg/oci"
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+type importOptions struct {
+	to        string
+	name      string
+	mountFrom []string
+	chunkSize int64
+	workers   int
+}
+
+func ImportArtifactCommand() *cobra.Command {
+	var opts importOptions
+
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import an artifact from an OCI image layout or tarball",
+		Long: `Push an artifact stored in an OCI image layout directory or a tarball of it, as
+written by 'harbor artifact export', into a Harbor project. Blobs already in the repository
+are skipped, blobs in the repositories given with --mount-from are mounted and the others
+are uploaded in chunks.`,
+		Example: `  harbor artifact import ./nginx --to library/nginx:1.27
+  harbor artifact import nginx.tar --to library/nginx --mount-from library/nginx-base`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			if opts.to == "" {
+				log.Errorf("--to is required")
+				return
+			}
+
+			err := importArtifact(args[0], opts)
+			if err != nil {
+				log.Errorf("failed to import artifact: %v", err)
+			}
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&opts.to, "to", "", "Destination as <project>/<repository>[:<tag>], the tag defaults to the name in the layout")
+	flags.StringVar(&opts.name, "name", "", "Name or digest of the manifest to import when the layout contains several")
+	flags.StringSliceVar(&opts.mountFrom, "mount-from", nil, "Repositories as <project>/<repository> to mount existing blobs from")
+	flags.Int64Var(&opts.chunkSize, "chunk-size", 16, "Size of upload chunks in MiB")
+	flags.IntVar(&opts.workers, "workers", 4, "Number of concurrent blob uploads")
+
+	return cmd
+}
+
+func importArtifact(source string, opts importOptions) error {
+	projectName, repoName, tag, err := parseImportTarget(opts.to)
+	if err != nil {
+		return err
+	}
+
+	info, err := os.Stat(source)
+	if err != nil {
+		return err
+	}
+	layoutDir := source
+	if !info.IsDir() {
+		layoutDir, err = os.MkdirTemp("", "harbor-import-")
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(layoutDir)
+
+		file, err := os.Open(source)
+		if err != nil {
+			return err
+		}
+		err = oci.ExtractTar(file, layoutDir)
+		file.Close()
+		if err != nil {
+			return fmt.Errorf("failed to extract %s: %v", source, err)
+		}
+	}
+
+	layout, err := oci.OpenLayout(layoutDir)
+	if err != nil {
+		return err
+	}
+
+	// Without an explicit tag, reuse the name the manifest had when exported.
+	if tag == "" {
+		desc, err := layout.FindManifest(opts.name)
+		if err != nil {
+			return err
+		}
+		if name := desc.Annotations[oci.AnnotationRefName]; utils.ValidateTagName(name) {
+			tag = name
+		}
+	}
+
+	client, err := oci.NewClientFromCurrentCredential()
+	if err != nil {
+		return err
+	}
+
+	result, err := oci.Import(context.Background(), client, layout, opts.name, projectName+"/"+repoName, tag, oci.ImportOptions{
+		MountFrom: opts.mountFrom,
+		ChunkSize: opts.chunkSize << 20,
+		Workers:   opts.workers,
+	})
+	if err != nil {
+		return err
+	}
+
+	reference := tag
+	if reference == "" {
+		reference = result.Descriptor.Digest
+	}
+	log.Infof("Imported %s as %s: %d blobs, %s uploaded, %d mounted, %d already present",
+		source, utils.FormatArtifactReference(projectName, repoName, reference), result.Blobs,
+		utils.FormatSize(result.Uploaded), result.Mounted, result.Existing)
+	return nil
+}
+
+// parseImportTarget splits <project>/<repository>[:<tag>].
+func parseImportTarget(target string) (string, string, string, error) {
+	repository, tag := target, ""
+	if i := strings.LastIndex(target, ":"); i > strings.LastIndex(target, "/") {
+		repository, tag = target[:i], target[i+1:]
+	}
+
+	projectName, repoName, found := strings.Cut(repository, "/")
+	if !found || projectName == "" || repoName == "" {
+		return "", "", "", fmt.Errorf("invalid destination %q, must be <project>/<repository>[:<tag>]", target)
+	}
+	if tag != "" && !utils.ValidateTagName(tag) {
+		return "", "", "", fmt.Errorf("invalid tag %q", tag)
+	}
+	return projectName, repoName, tag, nil
+}
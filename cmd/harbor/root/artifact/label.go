@@ -0,0 +1,137 @@
+package artifact
+
+import (
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/api"
+	"github.com/goharbor/harbor-cli/pkg/prompt"
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	"github.com/goharbor/harbor-cli/pkg/views/label/list"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func ArtifactLabelsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "label",
+		Short:   "Manage labels of an artifact",
+		Example: `  harbor artifact label list <project>/<repository>/<reference>`,
+	}
+
+	cmd.AddCommand(
+		AddLabelCmd(),
+		RemoveLabelCmd(),
+		ListLabelCmd(),
+	)
+
+	return cmd
+}
+
+func AddLabelCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "add",
+		Short:   "Add a label to an artifact",
+		Long:    `Add a label to an artifact. Project labels take precedence over global labels with the same name.`,
+		Example: `harbor artifact label add <project>/<repository>/<reference> <label>`,
+		Args:    cobra.MaximumNArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			projectName, repoName, reference, labelId, err := getArtifactLabel(args)
+			if err != nil {
+				log.Errorf("failed to resolve label: %v", err)
+				return
+			}
+
+			err = api.AddLabel(projectName, repoName, reference, labelId)
+			if err != nil {
+				log.Errorf("failed to add label: %v", err)
+			}
+		},
+	}
+
+	return cmd
+}
+
+func RemoveLabelCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove",
+		Short:   "Remove a label from an artifact",
+		Example: `harbor artifact label remove <project>/<repository>/<reference> <label>`,
+		Args:    cobra.MaximumNArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			projectName, repoName, reference, labelId, err := getArtifactLabel(args)
+			if err != nil {
+				log.Errorf("failed to resolve label: %v", err)
+				return
+			}
+
+			err = api.RemoveLabel(projectName, repoName, reference, labelId)
+			if err != nil {
+				log.Errorf("failed to remove label: %v", err)
+			}
+		},
+	}
+
+	return cmd
+}
+
+func ListLabelCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List labels of an artifact",
+		Example: `harbor artifact label list <project>/<repository>/<reference>`,
+		Args:    cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			var err error
+			var labels []*models.Label
+			var projectName, repoName, reference string
+
+			if len(args) > 0 {
+				projectName, repoName, reference = utils.ParseProjectRepoReference(args[0])
+			} else {
+				projectName = prompt.GetProjectNameFromUser()
+				repoName = prompt.GetRepoNameFromUser(projectName)
+				reference = prompt.GetReferenceFromUser(repoName, projectName)
+			}
+
+			labels, err = api.ListArtifactLabels(projectName, repoName, reference)
+			if err != nil {
+				log.Errorf("failed to list labels: %v", err)
+				return
+			}
+
+			FormatFlag := viper.GetString("output-format")
+			if FormatFlag != "" {
+				err = utils.PrintFormat(labels, FormatFlag)
+				if err != nil {
+					log.Error(err)
+				}
+			} else {
+				list.ListLabels(labels)
+			}
+		},
+	}
+
+	return cmd
+}
+
+// getArtifactLabel resolves the artifact and label either from the
+// arguments or interactively when none are given.
+func getArtifactLabel(args []string) (string, string, string, int64, error) {
+	var projectName, repoName, reference string
+
+	if len(args) > 0 {
+		projectName, repoName, reference = utils.ParseProjectRepoReference(args[0])
+	} else {
+		projectName = prompt.GetProjectNameFromUser()
+		repoName = prompt.GetRepoNameFromUser(projectName)
+		reference = prompt.GetReferenceFromUser(repoName, projectName)
+	}
+
+	if len(args) > 1 {
+		labelId, err := api.GetProjectOrGlobalLabelIdByName(projectName, args[1])
+		return projectName, repoName, reference, labelId, err
+	}
+
+	labelId := prompt.GetLabelIdFromUser(api.ListFlags{})
+	return projectName, repoName, reference, labelId, nil
+}
@@ -1,6 +1,12 @@
 package artifact
 
 import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/goharbor/go-client/pkg/sdk/v2.0/client/artifact"
 	"github.com/goharbor/harbor-cli/pkg/api"
 	"github.com/goharbor/harbor-cli/pkg/prompt"
@@ -13,11 +19,16 @@ import (
 
 func ListArtifactCommand() *cobra.Command {
 	var opts api.ListFlags
+	var labels []string
+	var filters artifactFilters
 
 	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "list artifacts within a repository",
-		Args:  cobra.MaximumNArgs(1),
+		Example: `  harbor artifact list library/nginx --type image --tag latest
+  harbor artifact list library/nginx --pushed-after 7d --with-scan-overview
+  harbor artifact list library/nginx --pulled-before 2024-01-01`,
+		Args: cobra.MaximumNArgs(1),
 		Run: func(cmd *cobra.Command, args []string) {
 			var err error
 			var artifacts artifact.ListArtifactsOK
@@ -30,6 +41,22 @@ func ListArtifactCommand() *cobra.Command {
 				repoName = prompt.GetRepoNameFromUser(projectName)
 			}
 
+			if len(labels) > 0 {
+				labelQuery, err := buildLabelQuery(projectName, labels)
+				if err != nil {
+					log.Errorf("failed to resolve labels: %v", err)
+					return
+				}
+				opts.Q = appendQuery(opts.Q, labelQuery)
+			}
+
+			filterQuery, err := filters.query(time.Now())
+			if err != nil {
+				log.Errorf("invalid filter: %v", err)
+				return
+			}
+			opts.Q = appendQuery(opts.Q, filterQuery)
+
 			artifacts, err = api.ListArtifact(projectName, repoName, opts)
 
 			if err != nil {
@@ -44,7 +71,10 @@ func ListArtifactCommand() *cobra.Command {
 					log.Error(err)
 				}
 			} else {
-				artifactViews.ListArtifacts(artifacts.Payload)
+				artifactViews.ListArtifacts(artifacts.Payload, artifactViews.ListOptions{
+					WithAccessories:  opts.WithAccessory,
+					WithScanOverview: opts.WithScanOverview,
+				})
 			}
 		},
 	}
@@ -54,6 +84,84 @@ func ListArtifactCommand() *cobra.Command {
 	flags.Int64VarP(&opts.PageSize, "page-size", "n", 10, "Size of per page")
 	flags.StringVarP(&opts.Q, "query", "q", "", "Query string to query resources")
 	flags.StringVarP(&opts.Sort, "sort", "s", "", "Sort the resource list in ascending or descending order")
+	flags.StringSliceVarP(&labels, "label", "l", nil, "Only list artifacts carrying all the given labels")
+	flags.BoolVar(&opts.WithAccessory, "with-accessories", false, "Include accessories such as signatures and SBOMs")
+	flags.BoolVar(&opts.WithTag, "with-tag", true, "Include the tags of the artifacts")
+	flags.BoolVar(&opts.WithScanOverview, "with-scan-overview", false, "Include the vulnerability summary of the artifacts")
+	flags.StringVar(&filters.Type, "type", "", "Only list artifacts of the given type: image, chart or cnab")
+	flags.StringVarP(&filters.Tag, "tag", "t", "", "Only list artifacts with the given tag, \"*\" matches any tagged artifact")
+	flags.StringVar(&filters.PushedAfter, "pushed-after", "", "Only list artifacts pushed after a date, RFC3339 time or age such as 7d")
+	flags.StringVar(&filters.PulledBefore, "pulled-before", "", "Only list artifacts last pulled before a date, RFC3339 time or age such as 30d")
 
 	return cmd
 }
+
+// buildLabelQuery resolves label names to IDs and returns the Harbor query
+// matching artifacts that carry all of them.
+func buildLabelQuery(projectName string, labels []string) (string, error) {
+	ids := make([]string, 0, len(labels))
+	for _, name := range labels {
+		id, err := api.GetProjectOrGlobalLabelIdByName(projectName, name)
+		if err != nil {
+			return "", err
+		}
+		ids = append(ids, strconv.FormatInt(id, 10))
+	}
+	return fmt.Sprintf("labels=(%s)", strings.Join(ids, " ")), nil
+}
+
+// artifactFilters holds the typed filters translated into Harbor's q syntax.
+type artifactFilters struct {
+	Type         string
+	Tag          string
+	PushedAfter  string
+	PulledBefore string
+}
+
+var artifactTypes = []string{"image", "chart", "cnab"}
+
+// harborTimeLayout is the time layout accepted by Harbor range queries,
+// which compare against UTC times.
+const harborTimeLayout = "2006-01-02 15:04:05"
+
+func (f artifactFilters) query(now time.Time) (string, error) {
+	var query string
+
+	if f.Type != "" {
+		t := strings.ToLower(f.Type)
+		if !slices.Contains(artifactTypes, t) {
+			return "", fmt.Errorf("unknown artifact type %q, must be one of %v", f.Type, artifactTypes)
+		}
+		query = appendQuery(query, "type="+strings.ToUpper(t))
+	}
+	if f.Tag != "" {
+		query = appendQuery(query, "tags="+f.Tag)
+	}
+	if f.PushedAfter != "" {
+		t, err := utils.ParseTimeOrAge(f.PushedAfter, now)
+		if err != nil {
+			return "", err
+		}
+		query = appendQuery(query, fmt.Sprintf("push_time=[%s~]", t.UTC().Format(harborTimeLayout)))
+	}
+	if f.PulledBefore != "" {
+		t, err := utils.ParseTimeOrAge(f.PulledBefore, now)
+		if err != nil {
+			return "", err
+		}
+		query = appendQuery(query, fmt.Sprintf("pull_time=[~%s]", t.UTC().Format(harborTimeLayout)))
+	}
+
+	return query, nil
+}
+
+// appendQuery joins query expressions with the separator used by Harbor.
+func appendQuery(query, expr string) string {
+	if expr == "" {
+		return query
+	}
+	if query == "" {
+		return expr
+	}
+	return query + "," + expr
+}
@@ -0,0 +1,54 @@
+package artifact
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArtifactFiltersQuery(t *testing.T) {
+	// Times are sent to Harbor in UTC whatever the local zone.
+	zone := time.FixedZone("UTC+2", 2*60*60)
+	now := time.Date(2024, 5, 10, 14, 0, 0, 0, zone)
+
+	tests := []struct {
+		name    string
+		filters artifactFilters
+		want    string
+		wantErr bool
+	}{
+		{name: "none", filters: artifactFilters{}, want: ""},
+		{name: "type", filters: artifactFilters{Type: "Chart"}, want: "type=CHART"},
+		{name: "unknown type", filters: artifactFilters{Type: "wasm"}, wantErr: true},
+		{name: "tag", filters: artifactFilters{Tag: "~release"}, want: "tags=~release"},
+		{
+			name:    "pushed after an age",
+			filters: artifactFilters{PushedAfter: "2d"},
+			want:    "push_time=[2024-05-08 12:00:00~]",
+		},
+		{
+			name:    "pulled before a time",
+			filters: artifactFilters{PulledBefore: "2024-05-01T08:00:00+02:00"},
+			want:    "pull_time=[~2024-05-01 06:00:00]",
+		},
+		{
+			name:    "combined",
+			filters: artifactFilters{Type: "image", Tag: "v1", PushedAfter: "1h"},
+			want:    "type=IMAGE,tags=v1,push_time=[2024-05-10 11:00:00~]",
+		},
+		{name: "invalid time", filters: artifactFilters{PushedAfter: "last week"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.filters.query(now)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package artifact
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/api"
+	"github.com/goharbor/harbor-cli/pkg/utils"
+)
+
+// resolvePlatform returns the digest of the child of the image index at
+// reference matching platform. When platform is empty the reference is
+// returned unchanged.
+func resolvePlatform(projectName, repoName, reference, platform string) (string, error) {
+	if platform == "" {
+		return reference, nil
+	}
+
+	response, err := api.ViewArtifact(projectName, repoName, reference)
+	if err != nil {
+		return "", err
+	}
+
+	ref, err := findPlatform(response.Payload, platform)
+	if err != nil {
+		return "", err
+	}
+	return ref.ChildDigest, nil
+}
+
+// findPlatform returns the reference of the index matching platform. A
+// platform without variant matches any variant when it is unambiguous.
+func findPlatform(index *models.Artifact, platform string) (*models.Reference, error) {
+	osName, arch, variant, err := utils.ParsePlatform(platform)
+	if err != nil {
+		return nil, err
+	}
+	if len(index.References) == 0 {
+		return nil, fmt.Errorf("%s is not an image index", utils.ShortDigest(index.Digest))
+	}
+
+	var matches []*models.Reference
+	var available []string
+	for _, ref := range index.References {
+		if ref.Platform == nil {
+			continue
+		}
+		available = append(available, utils.FormatPlatform(ref.Platform.Os, ref.Platform.Architecture, ref.Platform.Variant))
+		if ref.Platform.Os != osName || ref.Platform.Architecture != arch {
+			continue
+		}
+		if variant != "" && ref.Platform.Variant != variant {
+			continue
+		}
+		matches = append(matches, ref)
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no manifest for platform %s, available: %s", platform, strings.Join(available, ", "))
+	case 1:
+		return matches[0], nil
+	default:
+		return nil, fmt.Errorf("platform %s is ambiguous, specify the variant: %s", platform, strings.Join(available, ", "))
+	}
+}
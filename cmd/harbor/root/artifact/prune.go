@@ -0,0 +1,261 @@
+package artifact
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/api"
+	"github.com/goharbor/harbor-cli/pkg/prompt"
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	"github.com/goharbor/harbor-cli/pkg/views"
+	"github.com/goharbor/harbor-cli/pkg/views/artifact/prune"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type pruneOptions struct {
+	untagged      bool
+	olderThan     string
+	tagRegex      string
+	keepLast      int
+	excludeLabels []string
+	dryRun        bool
+	workers       int
+	yes           bool
+}
+
+func PruneArtifactCommand() *cobra.Command {
+	var opts pruneOptions
+
+	cmd := &cobra.Command{
+		Use:   "prune",
+		Short: "Delete artifacts of a repository matching the given filters",
+		Long: `Delete the artifacts of a repository matching all the given filters.
+The deletion plan is computed from every artifact in the repository. Use --dry-run
+to review the plan without deleting anything. An artifact is only matched by
+--tag-regex when all of its tags match, since deleting it removes every tag.`,
+		Example: `  harbor artifact prune library/app --untagged --dry-run
+  harbor artifact prune library/app --older-than 30d --keep-last 10
+  harbor artifact prune library/app --tag-regex '^pr-[0-9]+$' --exclude-label release`,
+		Args: cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			var projectName, repoName string
+
+			if len(args) > 0 {
+				projectName, repoName = utils.ParseProjectRepo(args[0])
+			} else {
+				projectName = prompt.GetProjectNameFromUser()
+				repoName = prompt.GetRepoNameFromUser(projectName)
+			}
+
+			err := runPrune(projectName, repoName, opts)
+			if err != nil {
+				log.Errorf("failed to prune artifacts: %v", err)
+			}
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.BoolVar(&opts.untagged, "untagged", false, "Only delete artifacts without tags")
+	flags.StringVar(&opts.olderThan, "older-than", "", "Only delete artifacts pushed before this age, e.g. 30d, 2w, 12h")
+	flags.StringVar(&opts.tagRegex, "tag-regex", "", "Only delete artifacts whose tags all match this regular expression")
+	flags.IntVar(&opts.keepLast, "keep-last", 0, "Always keep the N most recently pushed artifacts")
+	flags.StringSliceVar(&opts.excludeLabels, "exclude-label", nil, "Keep artifacts carrying this label, can be repeated")
+	flags.BoolVar(&opts.dryRun, "dry-run", false, "Show the artifacts that would be deleted without deleting them")
+	flags.IntVar(&opts.workers, "workers", 5, "Number of concurrent deletions")
+	flags.BoolVarP(&opts.yes, "yes", "y", false, "Delete without asking for confirmation")
+
+	return cmd
+}
+
+func runPrune(projectName, repoName string, opts pruneOptions) error {
+	if !opts.untagged && opts.olderThan == "" && opts.tagRegex == "" && opts.keepLast == 0 {
+		return fmt.Errorf("at least one of --untagged, --older-than, --tag-regex or --keep-last is required")
+	}
+	if opts.untagged && opts.tagRegex != "" {
+		return fmt.Errorf("--untagged and --tag-regex cannot be used together")
+	}
+	if opts.keepLast < 0 {
+		return fmt.Errorf("--keep-last cannot be negative")
+	}
+	if opts.workers < 1 {
+		return fmt.Errorf("--workers must be at least 1")
+	}
+
+	artifacts, err := api.ListAllArtifacts(projectName, repoName, api.ListFlags{WithLabel: true, WithTag: true})
+	if err != nil {
+		return err
+	}
+
+	plan, err := pruneArtifacts(artifacts, opts, time.Now())
+	if err != nil {
+		return err
+	}
+
+	FormatFlag := viper.GetString("output-format")
+	if opts.dryRun {
+		if FormatFlag != "" {
+			return utils.PrintFormat(plan, FormatFlag)
+		}
+		if len(plan) == 0 {
+			fmt.Println("No artifacts match the given filters")
+			return nil
+		}
+		prune.ListPrunePlan(plan)
+		return nil
+	}
+
+	if len(plan) == 0 {
+		fmt.Println("No artifacts match the given filters")
+		return nil
+	}
+
+	if !opts.yes {
+		confirm, err := views.ConfirmDeletion(fmt.Sprintf("Delete %d artifact(s) from %s/%s?", len(plan), projectName, repoName))
+		if err != nil {
+			return err
+		}
+		if !confirm {
+			return fmt.Errorf("deletion cancelled")
+		}
+	}
+
+	summary := deleteArtifacts(projectName, repoName, plan, opts.workers)
+	if FormatFlag != "" {
+		return utils.PrintFormat(summary, FormatFlag)
+	}
+	fmt.Printf("Deleted %d artifact(s) totalling %s, %d failed\n", summary.Deleted, utils.FormatSize(summary.FreedSize), len(summary.Failed))
+	fmt.Println("Storage is reclaimed by the next garbage collection run")
+	if len(summary.Failed) > 0 {
+		return fmt.Errorf("%d artifact(s) could not be deleted", len(summary.Failed))
+	}
+	return nil
+}
+
+// pruneArtifacts computes the deletion plan, newest artifacts first.
+func pruneArtifacts(artifacts []*models.Artifact, opts pruneOptions, now time.Time) ([]*models.Artifact, error) {
+	var tagRe *regexp.Regexp
+	if opts.tagRegex != "" {
+		re, err := regexp.Compile(opts.tagRegex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid tag regex: %v", err)
+		}
+		tagRe = re
+	}
+
+	var cutoff time.Time
+	if opts.olderThan != "" {
+		age, err := utils.ParseAge(opts.olderThan)
+		if err != nil {
+			return nil, err
+		}
+		cutoff = now.Add(-age)
+	}
+
+	excluded := map[string]bool{}
+	for _, label := range opts.excludeLabels {
+		excluded[label] = true
+	}
+
+	sorted := make([]*models.Artifact, len(artifacts))
+	copy(sorted, artifacts)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return time.Time(sorted[i].PushTime).After(time.Time(sorted[j].PushTime))
+	})
+
+	var plan []*models.Artifact
+	for i, artifact := range sorted {
+		if i < opts.keepLast {
+			continue
+		}
+		if opts.untagged && len(artifact.Tags) > 0 {
+			continue
+		}
+		if !cutoff.IsZero() && !time.Time(artifact.PushTime).Before(cutoff) {
+			continue
+		}
+		if tagRe != nil && !allTagsMatch(artifact.Tags, tagRe) {
+			continue
+		}
+		if hasLabel(artifact.Labels, excluded) {
+			continue
+		}
+		plan = append(plan, artifact)
+	}
+
+	return plan, nil
+}
+
+func allTagsMatch(tags []*models.Tag, re *regexp.Regexp) bool {
+	if len(tags) == 0 {
+		return false
+	}
+	for _, tag := range tags {
+		if !re.MatchString(tag.Name) {
+			return false
+		}
+	}
+	return true
+}
+
+func hasLabel(labels []*models.Label, names map[string]bool) bool {
+	for _, label := range labels {
+		if names[label.Name] {
+			return true
+		}
+	}
+	return false
+}
+
+type pruneFailure struct {
+	Digest string `json:"digest"`
+	Error  string `json:"error"`
+}
+
+type pruneSummary struct {
+	Deleted   int            `json:"deleted"`
+	FreedSize int64          `json:"freed_size"`
+	Failed    []pruneFailure `json:"failed"`
+}
+
+// deleteArtifacts deletes the planned artifacts with a bounded number of
+// concurrent requests.
+func deleteArtifacts(projectName, repoName string, plan []*models.Artifact, workers int) pruneSummary {
+	var (
+		summary pruneSummary
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+	)
+
+	jobs := make(chan *models.Artifact)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for artifact := range jobs {
+				err := api.DeleteArtifact(projectName, repoName, artifact.Digest)
+				mu.Lock()
+				if err != nil {
+					summary.Failed = append(summary.Failed, pruneFailure{Digest: artifact.Digest, Error: err.Error()})
+				} else {
+					summary.Deleted++
+					summary.FreedSize += artifact.Size
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for _, artifact := range plan {
+		jobs <- artifact
+	}
+	close(jobs)
+	wg.Wait()
+
+	return summary
+}
@@ -0,0 +1,92 @@
+package artifact
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPruneArtifacts(t *testing.T) {
+	now := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+	artifact := func(digest string, age time.Duration, tags []string, labels ...string) *models.Artifact {
+		a := &models.Artifact{Digest: digest, PushTime: strfmt.DateTime(now.Add(-age))}
+		for _, tag := range tags {
+			a.Tags = append(a.Tags, &models.Tag{Name: tag})
+		}
+		for _, label := range labels {
+			a.Labels = append(a.Labels, &models.Label{Name: label})
+		}
+		return a
+	}
+	day := 24 * time.Hour
+	artifacts := []*models.Artifact{
+		artifact("sha256:old-untagged", 40*day, nil),
+		artifact("sha256:new-untagged", 1*day, nil),
+		artifact("sha256:pr-1", 35*day, []string{"pr-1"}),
+		artifact("sha256:pr-2-latest", 20*day, []string{"pr-2", "latest"}),
+		artifact("sha256:release", 60*day, []string{"v1.0.0"}, "release"),
+		artifact("sha256:pr-3", 2*day, []string{"pr-3"}),
+	}
+
+	tests := []struct {
+		name    string
+		opts    pruneOptions
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "untagged",
+			opts: pruneOptions{untagged: true},
+			want: []string{"sha256:new-untagged", "sha256:old-untagged"},
+		},
+		{
+			name: "untagged older than",
+			opts: pruneOptions{untagged: true, olderThan: "30d"},
+			want: []string{"sha256:old-untagged"},
+		},
+		{
+			name: "every tag must match the regex",
+			opts: pruneOptions{tagRegex: "^pr-[0-9]+$"},
+			want: []string{"sha256:pr-3", "sha256:pr-1"},
+		},
+		{
+			name: "keep last counts newest first",
+			opts: pruneOptions{keepLast: 4},
+			want: []string{"sha256:old-untagged", "sha256:release"},
+		},
+		{
+			name: "excluded labels are kept",
+			opts: pruneOptions{keepLast: 4, excludeLabels: []string{"release"}},
+			want: []string{"sha256:old-untagged"},
+		},
+		{
+			name:    "invalid regex",
+			opts:    pruneOptions{tagRegex: "("},
+			wantErr: true,
+		},
+		{
+			name:    "invalid age",
+			opts:    pruneOptions{olderThan: "a while"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan, err := pruneArtifacts(artifacts, tt.opts, now)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			var got []string
+			for _, a := range plan {
+				got = append(got, a.Digest)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -26,21 +26,27 @@ func ScanArtifactCommand() *cobra.Command {
 }
 
 func StartScanArtifactCommand() *cobra.Command {
+	var platform string
+
 	cmd := &cobra.Command{
 		Use:     "start",
 		Short:   "Start a scan of an artifact",
-		Long:    `Start a scan of an artifact in Harbor Repository`,
+		Long:    `Start a scan of an artifact in Harbor Repository. Scanning an image index scans all its platforms, use --platform to scan only one.`,
 		Example: `harbor artifact scan start <project>/<repository>/<reference>`,
 		Run: func(cmd *cobra.Command, args []string) {
 			var err error
+			var projectName, repoName, reference string
 
 			if len(args) > 0 {
-				projectName, repoName, reference := utils.ParseProjectRepoReference(args[0])
-				err = api.StartScanArtifact(projectName, repoName, reference)
+				projectName, repoName, reference = utils.ParseProjectRepoReference(args[0])
 			} else {
-				projectName := prompt.GetProjectNameFromUser()
-				repoName := prompt.GetRepoNameFromUser(projectName)
-				reference := prompt.GetReferenceFromUser(repoName, projectName)
+				projectName = prompt.GetProjectNameFromUser()
+				repoName = prompt.GetRepoNameFromUser(projectName)
+				reference = prompt.GetReferenceFromUser(repoName, projectName)
+			}
+
+			reference, err = resolvePlatform(projectName, repoName, reference, platform)
+			if err == nil {
 				err = api.StartScanArtifact(projectName, repoName, reference)
 			}
 			if err != nil {
@@ -48,10 +54,15 @@ func StartScanArtifactCommand() *cobra.Command {
 			}
 		},
 	}
+
+	cmd.Flags().StringVar(&platform, "platform", "", "Scan only the manifest of the given platform of an image index, e.g. linux/arm64")
+
 	return cmd
 }
 
 func StopScanArtifactCommand() *cobra.Command {
+	var platform string
+
 	cmd := &cobra.Command{
 		Use:     "stop",
 		Short:   "Stop a scan of an artifact",
@@ -59,14 +70,18 @@ func StopScanArtifactCommand() *cobra.Command {
 		Example: `harbor artifact scan stop <project>/<repository>/<reference>`,
 		Run: func(cmd *cobra.Command, args []string) {
 			var err error
+			var projectName, repoName, reference string
 
 			if len(args) > 0 {
-				projectName, repoName, reference := utils.ParseProjectRepoReference(args[0])
-				err = api.StopScanArtifact(projectName, repoName, reference)
+				projectName, repoName, reference = utils.ParseProjectRepoReference(args[0])
 			} else {
-				projectName := prompt.GetProjectNameFromUser()
-				repoName := prompt.GetRepoNameFromUser(projectName)
-				reference := prompt.GetReferenceFromUser(repoName, projectName)
+				projectName = prompt.GetProjectNameFromUser()
+				repoName = prompt.GetRepoNameFromUser(projectName)
+				reference = prompt.GetReferenceFromUser(repoName, projectName)
+			}
+
+			reference, err = resolvePlatform(projectName, repoName, reference, platform)
+			if err == nil {
 				err = api.StopScanArtifact(projectName, repoName, reference)
 			}
 			if err != nil {
@@ -74,5 +89,8 @@ func StopScanArtifactCommand() *cobra.Command {
 			}
 		},
 	}
+
+	cmd.Flags().StringVar(&platform, "platform", "", "Stop the scan of the given platform of an image index, e.g. linux/arm64")
+
 	return cmd
 }
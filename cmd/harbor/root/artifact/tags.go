@@ -1,12 +1,17 @@
 package artifact
 
 import (
+	"errors"
+	"fmt"
+	"strings"
+
 	"github.com/goharbor/go-client/pkg/sdk/v2.0/client/artifact"
 	"github.com/goharbor/harbor-cli/pkg/api"
 	"github.com/goharbor/harbor-cli/pkg/prompt"
 	"github.com/goharbor/harbor-cli/pkg/utils"
 	"github.com/goharbor/harbor-cli/pkg/views/artifact/tags/create"
 	"github.com/goharbor/harbor-cli/pkg/views/artifact/tags/list"
+	immutableList "github.com/goharbor/harbor-cli/pkg/views/immutable/list"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -105,18 +110,21 @@ func DeleteTagsCmd() *cobra.Command {
 		Short:   "Delete a tag of an artifact",
 		Example: `harbor artifact tags delete <project>/<repository>/<reference> <tag>`,
 		Run: func(cmd *cobra.Command, args []string) {
-			var err error
+			var projectName, repoName, reference, tag string
 
 			if len(args) > 0 {
-				projectName, repoName, reference := utils.ParseProjectRepoReference(args[0])
-				tag := args[1]
-				err = api.DeleteTag(projectName, repoName, reference, tag)
+				projectName, repoName, reference = utils.ParseProjectRepoReference(args[0])
+				tag = args[1]
 			} else {
-				projectName := prompt.GetProjectNameFromUser()
-				repoName := prompt.GetRepoNameFromUser(projectName)
-				reference := prompt.GetReferenceFromUser(repoName, projectName)
-				tag := prompt.GetTagFromUser(repoName, projectName, reference)
-				err = api.DeleteTag(projectName, repoName, reference, tag)
+				projectName = prompt.GetProjectNameFromUser()
+				repoName = prompt.GetRepoNameFromUser(projectName)
+				reference = prompt.GetReferenceFromUser(repoName, projectName)
+				tag = prompt.GetTagFromUser(repoName, projectName, reference)
+			}
+
+			err := api.DeleteTag(projectName, repoName, reference, tag)
+			if errors.Is(err, api.ErrTagImmutable) {
+				err = explainImmutable(projectName, repoName, tag, err)
 			}
 			if err != nil {
 				log.Errorf("failed to delete tag: %v", err)
@@ -126,3 +134,20 @@ func DeleteTagsCmd() *cobra.Command {
 
 	return cmd
 }
+
+// explainImmutable names the immutable rules that keep a tag from being deleted.
+func explainImmutable(projectName, repoName, tag string, err error) error {
+	rules, listErr := api.FindImmutableRules(projectName, repoName, tag)
+	if listErr != nil || len(rules) == 0 {
+		return err
+	}
+
+	var reasons, commands []string
+	for _, rule := range rules {
+		reasons = append(reasons, fmt.Sprintf("rule %d (repositories %s, tags %s)",
+			rule.ID, immutableList.Repositories(rule), immutableList.Tags(rule)))
+		commands = append(commands, fmt.Sprintf("'harbor immutable disable %s %d'", projectName, rule.ID))
+	}
+	return fmt.Errorf("tag %s is protected by immutable %s; run %s to allow deleting it",
+		tag, strings.Join(reasons, " and "), strings.Join(commands, " and "))
+}
@@ -0,0 +1,131 @@
+package artifact
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/goharbor/harbor-cli/pkg/api"
+	"github.com/goharbor/harbor-cli/pkg/oci"
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	"github.com/goharbor/harbor-cli/pkg/views/artifact/verify"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// accessoryTypeCosign is the type Harbor gives to cosign signatures.
+const accessoryTypeCosign = "signature.cosign"
+
+func VerifyArtifactCommand() *cobra.Command {
+	var keyPath string
+
+	cmd := &cobra.Command{
+		Use:   "verify",
+		Short: "Verify the cosign signatures of an artifact",
+		Long: `Verify the cosign signatures attached to an artifact in Harbor against a public key.
+The signature payloads are fetched from Harbor and checked locally, including that they were
+made for the digest of the artifact. The command exits with a non-zero status unless at least
+one signature is valid.`,
+		Example: `harbor artifact verify library/nginx/1.27 --key cosign.pub`,
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			if keyPath == "" {
+				log.Errorf("--key is required")
+				os.Exit(1)
+			}
+
+			results, err := verifyArtifact(args[0], keyPath)
+			if err != nil {
+				log.Errorf("failed to verify artifact: %v", err)
+				os.Exit(1)
+			}
+
+			FormatFlag := viper.GetString("output-format")
+			if FormatFlag != "" {
+				err = utils.PrintFormat(results, FormatFlag)
+				if err != nil {
+					log.Error(err)
+				}
+			} else {
+				verify.ListResults(results)
+			}
+
+			for _, result := range results {
+				if result.Verified {
+					return
+				}
+			}
+			log.Errorf("no valid signature found for %s", args[0])
+			os.Exit(1)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&keyPath, "key", "", "Path to the PEM encoded cosign public key")
+
+	return cmd
+}
+
+func verifyArtifact(ref, keyPath string) ([]verify.Result, error) {
+	content, err := os.ReadFile(keyPath)
+	if err != nil {
+		return nil, err
+	}
+	key, err := oci.LoadPublicKey(content)
+	if err != nil {
+		return nil, err
+	}
+
+	projectName, repoName, reference := utils.ParseProjectRepoReference(ref)
+	response, err := api.ViewArtifact(projectName, repoName, reference)
+	if err != nil {
+		return nil, err
+	}
+	digest := response.Payload.Digest
+
+	accessories, err := api.ListAccessories(projectName, repoName, digest)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := oci.NewClientFromCurrentCredential()
+	if err != nil {
+		return nil, err
+	}
+	ctx := context.Background()
+	repository := projectName + "/" + repoName
+
+	results := []verify.Result{}
+	for _, accessory := range accessories {
+		if accessory.Type != accessoryTypeCosign {
+			continue
+		}
+
+		signatures, err := oci.FetchCosignSignatures(ctx, client, repository, accessory.Digest)
+		if err != nil {
+			results = append(results, verify.Result{Signature: accessory.Digest, Digest: digest, Error: err.Error()})
+			continue
+		}
+		for _, signature := range signatures {
+			result := verify.Result{
+				Signature: accessory.Digest,
+				Digest:    digest,
+				Signer:    signature.Signer(key),
+			}
+			payload, err := signature.Verify(key, digest)
+			if err != nil {
+				result.Error = err.Error()
+			} else {
+				result.Verified = true
+				result.DockerReference = payload.Critical.Identity.DockerReference
+			}
+			results = append(results, result)
+		}
+	}
+
+	if len(results) == 0 {
+		return nil, fmt.Errorf("%s has no cosign signatures", ref)
+	}
+	return results, nil
+}
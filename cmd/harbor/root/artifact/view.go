@@ -2,6 +2,7 @@ package artifact
 
 import (
 	"github.com/goharbor/go-client/pkg/sdk/v2.0/client/artifact"
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
 	"github.com/goharbor/harbor-cli/pkg/api"
 	"github.com/goharbor/harbor-cli/pkg/prompt"
 	"github.com/goharbor/harbor-cli/pkg/utils"
@@ -11,13 +12,22 @@ import (
 	"github.com/spf13/viper"
 )
 
+// indexInfo is the output of viewing an image index with its children.
+type indexInfo struct {
+	Index    *models.Artifact   `json:"index"`
+	Children []*models.Artifact `json:"children"`
+}
+
 func ViewArtifactCommmand() *cobra.Command {
+	var platform string
 
 	cmd := &cobra.Command{
-		Use:     "view",
-		Short:   "Get information of an artifact",
-		Long:    `Get information of an artifact`,
-		Example: `harbor artifact view <project>/<repository>/<reference>`,
+		Use:   "view",
+		Short: "Get information of an artifact",
+		Long: `Get information of an artifact. For an image index the manifest of every
+platform is listed with its size and scan status, use --platform to view a single one.`,
+		Example: `  harbor artifact view <project>/<repository>/<reference>
+  harbor artifact view <project>/<repository>/<reference> --platform linux/arm64`,
 		Run: func(cmd *cobra.Command, args []string) {
 			var err error
 			var projectName, repoName, reference string
@@ -31,6 +41,12 @@ func ViewArtifactCommmand() *cobra.Command {
 				reference = prompt.GetReferenceFromUser(repoName, projectName)
 			}
 
+			reference, err = resolvePlatform(projectName, repoName, reference, platform)
+			if err != nil {
+				log.Errorf("failed to resolve platform: %v", err)
+				return
+			}
+
 			artifact, err = api.ViewArtifact(projectName, repoName, reference)
 
 			if err != nil {
@@ -38,13 +54,28 @@ func ViewArtifactCommmand() *cobra.Command {
 				return
 			}
 
+			var children []*models.Artifact
+			if len(artifact.Payload.References) > 0 {
+				children, err = api.ListChildArtifacts(projectName, repoName, artifact.Payload)
+				if err != nil {
+					log.Errorf("failed to get manifests of the index: %v", err)
+					return
+				}
+			}
+
 			FormatFlag := viper.GetString("output-format")
 			if FormatFlag != "" {
-				err = utils.PrintFormat(artifact, FormatFlag)
+				if children != nil {
+					err = utils.PrintFormat(indexInfo{Index: artifact.Payload, Children: children}, FormatFlag)
+				} else {
+					err = utils.PrintFormat(artifact, FormatFlag)
+				}
 				if err != nil {
 					log.Error(err)
 					return
 				}
+			} else if children != nil {
+				view.ViewIndex(artifact.Payload, children)
 			} else {
 				view.ViewArtifact(artifact.Payload)
 			}
@@ -52,5 +83,8 @@ func ViewArtifactCommmand() *cobra.Command {
 		},
 	}
 
+	flags := cmd.Flags()
+	flags.StringVar(&platform, "platform", "", "View the manifest of the given platform of an image index, e.g. linux/arm64")
+
 	return cmd
 }
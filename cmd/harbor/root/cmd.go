@@ -4,12 +4,20 @@ import (
 	"fmt"
 
 	"github.com/goharbor/harbor-cli/cmd/harbor/root/artifact"
+	"github.com/goharbor/harbor-cli/cmd/harbor/root/cveallowlist"
+	"github.com/goharbor/harbor-cli/cmd/harbor/root/gc"
+	"github.com/goharbor/harbor-cli/cmd/harbor/root/immutable"
 	"github.com/goharbor/harbor-cli/cmd/harbor/root/labels"
 	"github.com/goharbor/harbor-cli/cmd/harbor/root/project"
+	"github.com/goharbor/harbor-cli/cmd/harbor/root/quota"
 	"github.com/goharbor/harbor-cli/cmd/harbor/root/registry"
+	"github.com/goharbor/harbor-cli/cmd/harbor/root/replication"
 	repositry "github.com/goharbor/harbor-cli/cmd/harbor/root/repository"
+	"github.com/goharbor/harbor-cli/cmd/harbor/root/retention"
+	"github.com/goharbor/harbor-cli/cmd/harbor/root/robot"
 	"github.com/goharbor/harbor-cli/cmd/harbor/root/schedule"
 	"github.com/goharbor/harbor-cli/cmd/harbor/root/user"
+	"github.com/goharbor/harbor-cli/cmd/harbor/root/webhook"
 	"github.com/goharbor/harbor-cli/pkg/utils"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -69,6 +77,14 @@ harbor help
 		HealthCommand(),
 		schedule.Schedule(),
 		labels.Labels(),
+		robot.Robot(),
+		quota.Quota(),
+		retention.Retention(),
+		immutable.Immutable(),
+		webhook.Webhook(),
+		cveallowlist.CVEAllowlist(),
+		replication.Replication(),
+		gc.GC(),
 	)
 
 	return root
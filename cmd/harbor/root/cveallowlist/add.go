@@ -0,0 +1,63 @@
+package cveallowlist
+
+import (
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func AddCVEAllowlistCommand() *cobra.Command {
+	var projectName, expires string
+
+	cmd := &cobra.Command{
+		Use:   "add <CVE ID>...",
+		Short: "add CVEs to the system or project CVE allowlist",
+		Long: `Add CVEs to the system allowlist, or to the allowlist of a project. CVEs already in the
+allowlist are skipped and the expiry is kept unless --expires is given.`,
+		Example: `  harbor cve-allowlist add CVE-2023-44487 CVE-2024-3094
+  harbor cve-allowlist add CVE-2023-44487 --project library --expires 2025-12-31`,
+		Args: cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			cves, err := parseCVEIDs(args)
+			if err != nil {
+				log.Errorf("failed to add CVEs: %v", err)
+				return
+			}
+
+			allowlist, reuse, err := getAllowlist(projectName)
+			if err != nil {
+				log.Errorf("failed to add CVEs: %v", err)
+				return
+			}
+			if cmd.Flags().Changed("expires") {
+				seconds, err := parseExpiry(expires, time.Now())
+				if err != nil {
+					log.Errorf("failed to add CVEs: %v", err)
+					return
+				}
+				allowlist.ExpiresAt = expiresAt(seconds)
+			}
+
+			var added int
+			allowlist.Items, added = mergeItems(allowlist.Items, cves)
+			if added == 0 && !reuse && !cmd.Flags().Changed("expires") {
+				log.Infof("All CVEs are already in the %s", scopeName(projectName))
+				return
+			}
+			if reuse {
+				log.Infof("Project %s will use its own CVE allowlist instead of the system one", projectName)
+			}
+
+			err = saveAllowlist(projectName, allowlist)
+			if err != nil {
+				log.Errorf("failed to add CVEs: %v", err)
+			}
+		},
+	}
+
+	addProjectFlag(cmd, &projectName)
+	addExpiresFlag(cmd, &expires)
+
+	return cmd
+}
@@ -0,0 +1,44 @@
+package cveallowlist
+
+import (
+	"fmt"
+
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/api"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func ClearCVEAllowlistCommand() *cobra.Command {
+	var projectName string
+	var reuseSystem bool
+
+	cmd := &cobra.Command{
+		Use:   "clear",
+		Short: "remove all CVEs from the system or project CVE allowlist",
+		Long: `Remove all CVEs from the system allowlist, or from the allowlist of a project. With
+--reuse-system the project goes back to using the system allowlist instead.`,
+		Example: `  harbor cve-allowlist clear
+  harbor cve-allowlist clear --project library --reuse-system`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			var err error
+			switch {
+			case reuseSystem && projectName == "":
+				err = fmt.Errorf("--reuse-system requires --project")
+			case reuseSystem:
+				err = api.UpdateProjectCVEAllowlist(projectName, nil, true)
+			default:
+				err = saveAllowlist(projectName, &models.CVEAllowlist{})
+			}
+			if err != nil {
+				log.Errorf("failed to clear CVE allowlist: %v", err)
+			}
+		},
+	}
+
+	addProjectFlag(cmd, &projectName)
+	cmd.Flags().BoolVar(&reuseSystem, "reuse-system", false, "Make the project use the system allowlist again")
+
+	return cmd
+}
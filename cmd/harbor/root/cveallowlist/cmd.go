@@ -0,0 +1,220 @@
+package cveallowlist
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/api"
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+func CVEAllowlist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cve-allowlist",
+		Short: "Manage the system and project CVE allowlists",
+		Long: `Manage the CVEs ignored when Harbor prevents vulnerable images from being pulled.
+Without --project the commands act on the system allowlist, with it on the allowlist of
+that project. A project given its own allowlist stops using the system one.`,
+		Example: `  harbor cve-allowlist get
+  harbor cve-allowlist add CVE-2023-44487 --project library --expires 90d
+  harbor cve-allowlist set --project library --from-file allowlist.txt`,
+	}
+	cmd.AddCommand(
+		GetCVEAllowlistCommand(),
+		AddCVEAllowlistCommand(),
+		RemoveCVEAllowlistCommand(),
+		SetCVEAllowlistCommand(),
+		ClearCVEAllowlistCommand(),
+	)
+
+	return cmd
+}
+
+func addProjectFlag(cmd *cobra.Command, projectName *string) {
+	cmd.Flags().StringVarP(projectName, "project", "p", "", "Project whose allowlist to manage instead of the system allowlist")
+}
+
+func addExpiresFlag(cmd *cobra.Command, expires *string) {
+	cmd.Flags().StringVar(expires, "expires", "", "Expiry as a date, an RFC3339 time, a duration from now such as 90d, or never")
+}
+
+// getAllowlist returns the allowlist of the project, or the system allowlist
+// when projectName is empty, and whether the project reuses the system one.
+func getAllowlist(projectName string) (*models.CVEAllowlist, bool, error) {
+	if projectName == "" {
+		allowlist, err := api.GetSystemCVEAllowlist()
+		return allowlist, false, err
+	}
+
+	project, err := api.GetProject(projectName)
+	if err != nil {
+		return nil, false, err
+	}
+	reuse := false
+	if metadata := project.Payload.Metadata; metadata != nil && metadata.ReuseSysCVEAllowlist != nil {
+		reuse = *metadata.ReuseSysCVEAllowlist == "true"
+	}
+	allowlist := project.Payload.CVEAllowlist
+	if allowlist == nil {
+		allowlist = &models.CVEAllowlist{}
+	}
+	return allowlist, reuse, nil
+}
+
+func saveAllowlist(projectName string, allowlist *models.CVEAllowlist) error {
+	update := &models.CVEAllowlist{
+		ExpiresAt: allowlist.ExpiresAt,
+		Items:     allowlist.Items,
+	}
+	if update.Items == nil {
+		update.Items = []*models.CVEAllowlistItem{}
+	}
+	if projectName == "" {
+		return api.UpdateSystemCVEAllowlist(update)
+	}
+	return api.UpdateProjectCVEAllowlist(projectName, update, false)
+}
+
+var cvePattern = regexp.MustCompile(`^CVE-\d{4}-\d{4,}$`)
+
+// parseCVEIDs validates and normalizes CVE IDs such as cve-2023-44487.
+func parseCVEIDs(ids []string) ([]string, error) {
+	var cves []string
+	for _, id := range ids {
+		cve := strings.ToUpper(strings.TrimSpace(id))
+		if !cvePattern.MatchString(cve) {
+			return nil, fmt.Errorf("invalid CVE ID %q, must look like CVE-2023-44487", id)
+		}
+		cves = append(cves, cve)
+	}
+	return cves, nil
+}
+
+// mergeItems returns the items followed by the CVEs not already in them.
+func mergeItems(items []*models.CVEAllowlistItem, cves []string) ([]*models.CVEAllowlistItem, int) {
+	seen := map[string]bool{}
+	for _, item := range items {
+		seen[item.CVEID] = true
+	}
+	added := 0
+	for _, cve := range cves {
+		if seen[cve] {
+			continue
+		}
+		seen[cve] = true
+		items = append(items, &models.CVEAllowlistItem{CVEID: cve})
+		added++
+	}
+	return items, added
+}
+
+// parseExpiry parses the value of --expires into seconds since epoch, 0
+// meaning the allowlist never expires.
+func parseExpiry(value string, now time.Time) (int64, error) {
+	value = strings.TrimSpace(value)
+	if strings.EqualFold(value, "never") {
+		return 0, nil
+	}
+
+	var expires time.Time
+	if age, err := utils.ParseAge(value); err == nil {
+		expires = now.Add(age)
+	} else if t, err := time.Parse(time.RFC3339, value); err == nil {
+		expires = t
+	} else if t, err := time.ParseInLocation("2006-01-02", value, time.Local); err == nil {
+		// A date allows the whole day.
+		expires = t.Add(24*time.Hour - time.Second)
+	} else {
+		return 0, fmt.Errorf("invalid expiry %q, use a date, an RFC3339 time, a duration such as 90d or never", value)
+	}
+
+	if !expires.After(now) {
+		return 0, fmt.Errorf("expiry %s is in the past", value)
+	}
+	return expires.Unix(), nil
+}
+
+// expiresAt returns the expires_at of an allowlist expiring at seconds,
+// which Harbor leaves unset for allowlists that never expire.
+func expiresAt(seconds int64) *int64 {
+	if seconds == 0 {
+		return nil
+	}
+	return &seconds
+}
+
+// readAllowlistFile reads an allowlist from a file. The file is either the
+// JSON printed by 'harbor cve-allowlist get -o json' or text with one CVE ID
+// per line, an optional "expires: <date>" line, and comments starting with #.
+func readAllowlistFile(path string, now time.Time) (*models.CVEAllowlist, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if trimmed := bytes.TrimSpace(content); bytes.HasPrefix(trimmed, []byte("{")) {
+		var allowlist models.CVEAllowlist
+		if err := json.Unmarshal(trimmed, &allowlist); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %v", path, err)
+		}
+		var ids []string
+		for _, item := range allowlist.Items {
+			ids = append(ids, item.CVEID)
+		}
+		cves, err := parseCVEIDs(ids)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", path, err)
+		}
+		allowlist.Items, _ = mergeItems(nil, cves)
+		return &allowlist, nil
+	}
+
+	allowlist := &models.CVEAllowlist{}
+	var cves []string
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		if key, value, found := strings.Cut(line, ":"); found && strings.EqualFold(strings.TrimSpace(key), "expires") {
+			seconds, err := parseExpiry(value, now)
+			if err != nil {
+				return nil, fmt.Errorf("%s line %d: %v", path, lineNumber, err)
+			}
+			allowlist.ExpiresAt = expiresAt(seconds)
+			continue
+		}
+
+		ids, err := parseCVEIDs([]string{line})
+		if err != nil {
+			return nil, fmt.Errorf("%s line %d: %v", path, lineNumber, err)
+		}
+		cves = append(cves, ids...)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %v", path, err)
+	}
+
+	allowlist.Items, _ = mergeItems(nil, cves)
+	return allowlist, nil
+}
+
+func scopeName(projectName string) string {
+	if projectName == "" {
+		return "system CVE allowlist"
+	}
+	return "CVE allowlist of project " + projectName
+}
@@ -0,0 +1,146 @@
+package cveallowlist
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseExpiry(t *testing.T) {
+	now := time.Date(2024, 6, 10, 12, 0, 0, 0, time.UTC)
+	endOfDay, err := time.ParseInLocation("2006-01-02 15:04:05", "2024-12-31 23:59:59", time.Local)
+	require.NoError(t, err)
+
+	tests := []struct {
+		value   string
+		want    int64
+		wantErr string
+	}{
+		{value: "never", want: 0},
+		{value: " Never ", want: 0},
+		{value: "90d", want: now.Add(90 * 24 * time.Hour).Unix()},
+		{value: "2w", want: now.Add(14 * 24 * time.Hour).Unix()},
+		{value: "36h", want: now.Add(36 * time.Hour).Unix()},
+		{value: "2024-07-01T08:00:00+02:00", want: time.Date(2024, 7, 1, 6, 0, 0, 0, time.UTC).Unix()},
+		{value: "2024-12-31", want: endOfDay.Unix()},
+		{value: "0d", wantErr: "is in the past"},
+		{value: "2024-01-01", wantErr: "is in the past"},
+		{value: "2024-06-10T11:59:59Z", wantErr: "is in the past"},
+		{value: "next year", wantErr: "invalid expiry"},
+		{value: "", wantErr: "invalid expiry"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := parseExpiry(tt.value, now)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestReadAllowlistFile(t *testing.T) {
+	now := time.Date(2024, 6, 10, 12, 0, 0, 0, time.UTC)
+	items := func(cves ...string) []*models.CVEAllowlistItem {
+		var result []*models.CVEAllowlistItem
+		for _, cve := range cves {
+			result = append(result, &models.CVEAllowlistItem{CVEID: cve})
+		}
+		return result
+	}
+	expires := func(seconds int64) *int64 { return &seconds }
+
+	tests := []struct {
+		name    string
+		content string
+		want    *models.CVEAllowlist
+		wantErr string
+	}{
+		{
+			name: "text",
+			content: `# accepted until the base image is rebuilt
+CVE-2023-44487
+cve-2024-3094   # lower case is accepted
+
+expires: 30d
+CVE-2023-44487
+`,
+			want: &models.CVEAllowlist{
+				Items:     items("CVE-2023-44487", "CVE-2024-3094"),
+				ExpiresAt: expires(now.Add(30 * 24 * time.Hour).Unix()),
+			},
+		},
+		{
+			name:    "text that never expires",
+			content: "Expires: never\nCVE-2023-44487\n",
+			want:    &models.CVEAllowlist{Items: items("CVE-2023-44487")},
+		},
+		{
+			name:    "empty",
+			content: "# nothing allowed\n",
+			want:    &models.CVEAllowlist{},
+		},
+		{
+			name: "json from get",
+			content: `{
+  "id": 3,
+  "project_id": 1,
+  "expires_at": 1735689599,
+  "items": [{"cve_id": "CVE-2023-44487"}, {"cve_id": "cve-2024-3094"}, {"cve_id": "CVE-2023-44487"}]
+}`,
+			want: &models.CVEAllowlist{
+				ID:        3,
+				ProjectID: 1,
+				ExpiresAt: expires(1735689599),
+				Items:     items("CVE-2023-44487", "CVE-2024-3094"),
+			},
+		},
+		{
+			name:    "invalid CVE ID",
+			content: "CVE-2023-44487\nGHSA-xxxx-yyyy\n",
+			wantErr: `line 2: invalid CVE ID "GHSA-xxxx-yyyy"`,
+		},
+		{
+			name:    "invalid expiry",
+			content: "CVE-2023-44487\nexpires: 2020-01-01\n",
+			wantErr: "line 2: expiry 2020-01-01 is in the past",
+		},
+		{
+			name:    "invalid JSON",
+			content: `{"items": [}`,
+			wantErr: "failed to parse",
+		},
+		{
+			name:    "invalid CVE ID in JSON",
+			content: `{"items": [{"cve_id": "CVE-24"}]}`,
+			wantErr: `invalid CVE ID "CVE-24"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "allowlist")
+			require.NoError(t, os.WriteFile(path, []byte(tt.content), 0o600))
+
+			got, err := readAllowlistFile(path, now)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := readAllowlistFile(filepath.Join(t.TempDir(), "missing"), now)
+	assert.Error(t, err)
+}
@@ -0,0 +1,55 @@
+package cveallowlist
+
+import (
+	"github.com/goharbor/harbor-cli/pkg/api"
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	"github.com/goharbor/harbor-cli/pkg/views/cveallowlist/view"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func GetCVEAllowlistCommand() *cobra.Command {
+	var projectName string
+
+	cmd := &cobra.Command{
+		Use:   "get",
+		Short: "show the system or project CVE allowlist",
+		Long: `Show the CVEs in the system allowlist, or in the allowlist of a project. For a project
+that reuses the system allowlist, the system allowlist is shown.`,
+		Example: `  harbor cve-allowlist get
+  harbor cve-allowlist get --project library -o json > allowlist.json`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			allowlist, reuse, err := getAllowlist(projectName)
+			if err != nil {
+				log.Errorf("failed to get CVE allowlist: %v", err)
+				return
+			}
+
+			scope := scopeName(projectName)
+			if reuse {
+				allowlist, err = api.GetSystemCVEAllowlist()
+				if err != nil {
+					log.Errorf("failed to get CVE allowlist: %v", err)
+					return
+				}
+				scope = "Project " + projectName + " uses the system CVE allowlist"
+			}
+
+			FormatFlag := viper.GetString("output-format")
+			if FormatFlag != "" {
+				err = utils.PrintFormat(allowlist, FormatFlag)
+				if err != nil {
+					log.Error(err)
+				}
+			} else {
+				view.ViewAllowlist(scope, allowlist)
+			}
+		},
+	}
+
+	addProjectFlag(cmd, &projectName)
+
+	return cmd
+}
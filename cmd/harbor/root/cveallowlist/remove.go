@@ -0,0 +1,64 @@
+package cveallowlist
+
+import (
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func RemoveCVEAllowlistCommand() *cobra.Command {
+	var projectName string
+
+	cmd := &cobra.Command{
+		Use:     "remove <CVE ID>...",
+		Short:   "remove CVEs from the system or project CVE allowlist",
+		Example: `  harbor cve-allowlist remove CVE-2023-44487 --project library`,
+		Args:    cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			cves, err := parseCVEIDs(args)
+			if err != nil {
+				log.Errorf("failed to remove CVEs: %v", err)
+				return
+			}
+
+			allowlist, reuse, err := getAllowlist(projectName)
+			if err != nil {
+				log.Errorf("failed to remove CVEs: %v", err)
+				return
+			}
+			if reuse {
+				log.Errorf("failed to remove CVEs: project %s uses the system CVE allowlist, remove them from it without --project", projectName)
+				return
+			}
+
+			remove := map[string]bool{}
+			for _, cve := range cves {
+				remove[cve] = true
+			}
+			var items []*models.CVEAllowlistItem
+			for _, item := range allowlist.Items {
+				if remove[item.CVEID] {
+					delete(remove, item.CVEID)
+					continue
+				}
+				items = append(items, item)
+			}
+			for cve := range remove {
+				log.Warnf("%s is not in the %s", cve, scopeName(projectName))
+			}
+			if len(items) == len(allowlist.Items) {
+				return
+			}
+
+			allowlist.Items = items
+			err = saveAllowlist(projectName, allowlist)
+			if err != nil {
+				log.Errorf("failed to remove CVEs: %v", err)
+			}
+		},
+	}
+
+	addProjectFlag(cmd, &projectName)
+
+	return cmd
+}
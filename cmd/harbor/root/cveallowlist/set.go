@@ -0,0 +1,79 @@
+package cveallowlist
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func SetCVEAllowlistCommand() *cobra.Command {
+	var projectName, expires, fromFile string
+
+	cmd := &cobra.Command{
+		Use:   "set [CVE ID]...",
+		Short: "replace the system or project CVE allowlist",
+		Long: `Replace the system allowlist, or the allowlist of a project, with the CVEs given as
+arguments or read from a file, so the allowlist can be kept under version control.
+
+The file is either the JSON printed by 'harbor cve-allowlist get -o json' or text with one
+CVE ID per line, for example:
+
+  # Not reachable from our services, review with the next base image update.
+  expires: 2025-12-31
+  CVE-2023-44487
+  CVE-2024-3094   # only in the build stage
+
+The allowlist never expires unless --expires or the file sets an expiry.`,
+		Example: `  harbor cve-allowlist set CVE-2023-44487 --expires 30d
+  harbor cve-allowlist set --project library --from-file allowlist.txt`,
+		Run: func(cmd *cobra.Command, args []string) {
+			err := setAllowlist(cmd, projectName, fromFile, expires, args)
+			if err != nil {
+				log.Errorf("failed to set CVE allowlist: %v", err)
+			}
+		},
+	}
+
+	addProjectFlag(cmd, &projectName)
+	addExpiresFlag(cmd, &expires)
+	cmd.Flags().StringVarP(&fromFile, "from-file", "f", "", "Read the CVEs from a text or JSON file")
+
+	return cmd
+}
+
+func setAllowlist(cmd *cobra.Command, projectName, fromFile, expires string, args []string) error {
+	now := time.Now()
+	allowlist := &models.CVEAllowlist{}
+
+	switch {
+	case fromFile != "" && len(args) > 0:
+		return fmt.Errorf("CVE IDs cannot be given together with --from-file")
+	case fromFile != "":
+		var err error
+		allowlist, err = readAllowlistFile(fromFile, now)
+		if err != nil {
+			return err
+		}
+	case len(args) > 0:
+		cves, err := parseCVEIDs(args)
+		if err != nil {
+			return err
+		}
+		allowlist.Items, _ = mergeItems(nil, cves)
+	default:
+		return fmt.Errorf("CVE IDs or --from-file are required, use 'harbor cve-allowlist clear' to empty the allowlist")
+	}
+
+	if cmd.Flags().Changed("expires") {
+		seconds, err := parseExpiry(expires, now)
+		if err != nil {
+			return err
+		}
+		allowlist.ExpiresAt = expiresAt(seconds)
+	}
+
+	return saveAllowlist(projectName, allowlist)
+}
@@ -0,0 +1,43 @@
+package gc
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+func GC() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gc",
+		Short: "Run and schedule garbage collection",
+		Long: `Garbage collection deletes the blobs no longer referenced by any artifact from the
+storage of Harbor, freeing the space they use.`,
+		Example: `  harbor gc run --dry-run --wait
+  harbor gc schedule set daily --delete-untagged
+  harbor gc history`,
+	}
+	cmd.AddCommand(
+		RunGCCommand(),
+		ScheduleGCCommand(),
+		HistoryGCCommand(),
+		LogsGCCommand(),
+	)
+
+	return cmd
+}
+
+func addParameterFlags(cmd *cobra.Command, deleteUntagged *bool, workers *int) {
+	flags := cmd.Flags()
+	flags.BoolVar(deleteUntagged, "delete-untagged", false, "Also delete the untagged artifacts")
+	flags.IntVar(workers, "workers", 1, "Number of workers deleting blobs in parallel, from 1 to 5")
+}
+
+func gcParameters(deleteUntagged bool, workers int) (map[string]interface{}, error) {
+	if workers < 1 || workers > 5 {
+		return nil, fmt.Errorf("workers must be between 1 and 5")
+	}
+	return map[string]interface{}{
+		"delete_untagged": deleteUntagged,
+		"workers":         workers,
+	}, nil
+}
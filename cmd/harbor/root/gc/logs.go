@@ -0,0 +1,35 @@
+package gc
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/goharbor/harbor-cli/pkg/api"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func LogsGCCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "logs <GC job ID>",
+		Short:   "print the log of a garbage collection job",
+		Example: `harbor gc logs 42`,
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			gcID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				log.Errorf("invalid GC job ID: %s", args[0])
+				return
+			}
+
+			jobLog, err := api.GetGCLog(gcID)
+			if err != nil {
+				log.Errorf("failed to get GC log: %v", err)
+				return
+			}
+			fmt.Print(jobLog)
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,93 @@
+package gc
+
+import (
+	"strings"
+	"time"
+
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/api"
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	"github.com/goharbor/harbor-cli/pkg/views/gc/history"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func RunGCCommand() *cobra.Command {
+	var dryRun, deleteUntagged, wait bool
+	var workers int
+	var interval time.Duration
+
+	cmd := &cobra.Command{
+		Use:   "run",
+		Short: "run garbage collection now",
+		Long: `Start a garbage collection job. With --dry-run nothing is deleted and the job only
+reports the space it would free. With --wait the command returns once the job completes.`,
+		Example: `  harbor gc run --dry-run --wait
+  harbor gc run --delete-untagged --workers 3`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			parameters, err := gcParameters(deleteUntagged, workers)
+			if err != nil {
+				log.Errorf("failed to run GC: %v", err)
+				return
+			}
+			parameters["dry_run"] = dryRun
+
+			gcID, err := api.RunGC(parameters)
+			if err != nil {
+				log.Errorf("failed to run GC: %v", err)
+				return
+			}
+			log.Infof("GC job %d started", gcID)
+			if !wait {
+				return
+			}
+
+			job, err := waitGC(gcID, interval)
+			if err != nil {
+				log.Errorf("failed to wait for GC job %d: %v", gcID, err)
+				return
+			}
+
+			FormatFlag := viper.GetString("output-format")
+			if FormatFlag != "" {
+				err = utils.PrintFormat(job, FormatFlag)
+				if err != nil {
+					log.Error(err)
+				}
+			} else if job.JobStatus == "Success" {
+				log.Infof("GC job %d succeeded: %s", gcID, history.Details(job))
+			} else {
+				log.Errorf("GC job %d ended with status %s, run 'harbor gc logs %d' for details", gcID, job.JobStatus, gcID)
+			}
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.BoolVar(&dryRun, "dry-run", false, "Only report the space that would be freed")
+	addParameterFlags(cmd, &deleteUntagged, &workers)
+	flags.BoolVarP(&wait, "wait", "w", false, "Wait for the GC job to complete")
+	flags.DurationVar(&interval, "interval", 5*time.Second, "How often to check the GC job with --wait")
+
+	return cmd
+}
+
+// waitGC polls a GC job until it completes.
+func waitGC(gcID int64, interval time.Duration) (*models.GCHistory, error) {
+	status := ""
+	for {
+		job, err := api.GetGC(gcID)
+		if err != nil {
+			return nil, err
+		}
+		if !history.Running(job) {
+			return job, nil
+		}
+		if job.JobStatus != status {
+			status = job.JobStatus
+			log.Infof("GC job %d is %s", gcID, strings.ToLower(status))
+		}
+		time.Sleep(interval)
+	}
+}
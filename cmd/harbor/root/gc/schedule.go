@@ -0,0 +1,138 @@
+package gc
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/api"
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	"github.com/goharbor/harbor-cli/pkg/views/gc/history"
+	"github.com/goharbor/harbor-cli/pkg/views/gc/schedule"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func ScheduleGCCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule",
+		Short: "get or set the garbage collection schedule",
+	}
+	cmd.AddCommand(
+		GetScheduleGCCommand(),
+		SetScheduleGCCommand(),
+	)
+
+	return cmd
+}
+
+func GetScheduleGCCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get",
+		Short: "show the garbage collection schedule",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			job, err := api.GetGCSchedule()
+			if err != nil {
+				log.Errorf("failed to get GC schedule: %v", err)
+				return
+			}
+
+			FormatFlag := viper.GetString("output-format")
+			if FormatFlag != "" {
+				err = utils.PrintFormat(job, FormatFlag)
+				if err != nil {
+					log.Error(err)
+				}
+			} else {
+				schedule.ViewSchedule(job)
+			}
+		},
+	}
+
+	return cmd
+}
+
+func SetScheduleGCCommand() *cobra.Command {
+	var deleteUntagged bool
+	var workers int
+
+	cmd := &cobra.Command{
+		Use:   "set <hourly|daily|weekly|none|cron>",
+		Short: "set the garbage collection schedule",
+		Long: `Schedule garbage collection hourly, daily, weekly or with a cron expression of six
+fields starting with the seconds. With none, garbage collection only runs when started.
+--delete-untagged and --workers keep their current values when not given.`,
+		Example: `  harbor gc schedule set weekly --delete-untagged
+  harbor gc schedule set "0 0 2 * * 6" --workers 3
+  harbor gc schedule set none`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			scheduleObj, err := parseSchedule(args[0])
+			if err != nil {
+				log.Errorf("failed to set GC schedule: %v", err)
+				return
+			}
+
+			current, err := api.GetGCSchedule()
+			if err != nil {
+				log.Errorf("failed to set GC schedule: %v", err)
+				return
+			}
+			scheduled := current.Schedule != nil && current.Schedule.Type != "" && current.Schedule.Type != "None"
+			if !scheduled && scheduleObj.Type == "None" {
+				log.Infof("GC is not scheduled")
+				return
+			}
+
+			// Keep the parameters of the current schedule unless given.
+			if scheduled {
+				currentParameters := history.ParseParameters(current)
+				if !cmd.Flags().Changed("delete-untagged") {
+					deleteUntagged = currentParameters.DeleteUntagged
+				}
+				if !cmd.Flags().Changed("workers") && currentParameters.Workers > 0 {
+					workers = currentParameters.Workers
+				}
+			}
+			parameters, err := gcParameters(deleteUntagged, workers)
+			if err != nil {
+				log.Errorf("failed to set GC schedule: %v", err)
+				return
+			}
+
+			err = api.SetGCSchedule(&models.Schedule{
+				Schedule:   scheduleObj,
+				Parameters: parameters,
+			}, !scheduled)
+			if err != nil {
+				log.Errorf("failed to set GC schedule: %v", err)
+			}
+		},
+	}
+
+	addParameterFlags(cmd, &deleteUntagged, &workers)
+
+	return cmd
+}
+
+// parseSchedule turns hourly, daily, weekly, none or a cron expression into
+// the schedule Harbor expects.
+func parseSchedule(value string) (*models.ScheduleObj, error) {
+	switch strings.ToLower(value) {
+	case "hourly":
+		return &models.ScheduleObj{Type: "Hourly", Cron: "0 0 * * * *"}, nil
+	case "daily":
+		return &models.ScheduleObj{Type: "Daily", Cron: "0 0 0 * * *"}, nil
+	case "weekly":
+		return &models.ScheduleObj{Type: "Weekly", Cron: "0 0 0 * * 0"}, nil
+	case "none":
+		return &models.ScheduleObj{Type: "None"}, nil
+	}
+
+	if len(strings.Fields(value)) != 6 {
+		return nil, fmt.Errorf("invalid schedule %q, use hourly, daily, weekly, none or a cron expression of six fields such as \"0 0 2 * * 6\"", value)
+	}
+	return &models.ScheduleObj{Type: "Custom", Cron: value}, nil
+}
@@ -0,0 +1,50 @@
+package gc
+
+import (
+	"testing"
+
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSchedule(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    *models.ScheduleObj
+		wantErr bool
+	}{
+		{value: "hourly", want: &models.ScheduleObj{Type: "Hourly", Cron: "0 0 * * * *"}},
+		{value: "Daily", want: &models.ScheduleObj{Type: "Daily", Cron: "0 0 0 * * *"}},
+		{value: "WEEKLY", want: &models.ScheduleObj{Type: "Weekly", Cron: "0 0 0 * * 0"}},
+		{value: "none", want: &models.ScheduleObj{Type: "None"}},
+		{value: "0 0 2 * * 6", want: &models.ScheduleObj{Type: "Custom", Cron: "0 0 2 * * 6"}},
+		{value: "0 */30 * * * *", want: &models.ScheduleObj{Type: "Custom", Cron: "0 */30 * * * *"}},
+		{value: "0 2 * * 6", wantErr: true},
+		{value: "monthly", wantErr: true},
+		{value: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := parseSchedule(tt.value)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGCParameters(t *testing.T) {
+	parameters, err := gcParameters(true, 3)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"delete_untagged": true, "workers": 3}, parameters)
+
+	for _, workers := range []int{0, 6} {
+		_, err := gcParameters(false, workers)
+		assert.Error(t, err, "workers %d", workers)
+	}
+}
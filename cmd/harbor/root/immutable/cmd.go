@@ -0,0 +1,81 @@
+package immutable
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/api"
+	"github.com/spf13/cobra"
+)
+
+func Immutable() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "immutable",
+		Short: "Manage immutable tag rules of projects",
+		Long: `Manage the immutable tag rules of projects. Tags matched by an enabled rule cannot be
+overwritten or deleted.`,
+		Example: `  harbor immutable create library dev prod --tags 'release-*'
+  harbor immutable list library`,
+	}
+	cmd.AddCommand(
+		ListImmutableCommand(),
+		CreateImmutableCommand(),
+		UpdateImmutableCommand(),
+		DeleteImmutableCommand(),
+		EnableImmutableCommand(),
+		DisableImmutableCommand(),
+	)
+
+	return cmd
+}
+
+type selectorOptions struct {
+	repos        string
+	excludeRepos string
+	tags         string
+	excludeTags  string
+}
+
+func addSelectorFlags(cmd *cobra.Command, opts *selectorOptions) {
+	flags := cmd.Flags()
+	flags.StringVar(&opts.repos, "repos", "**", "Pattern of the repositories the rule applies to")
+	flags.StringVar(&opts.excludeRepos, "exclude-repos", "", "Pattern of the repositories the rule does not apply to")
+	flags.StringVar(&opts.tags, "tags", "**", "Pattern of the tags the rule applies to")
+	flags.StringVar(&opts.excludeTags, "exclude-tags", "", "Pattern of the tags the rule does not apply to")
+	cmd.MarkFlagsMutuallyExclusive("repos", "exclude-repos")
+	cmd.MarkFlagsMutuallyExclusive("tags", "exclude-tags")
+}
+
+func repoSelector(opts selectorOptions) models.ImmutableSelector {
+	if opts.excludeRepos != "" {
+		return models.ImmutableSelector{Kind: "doublestar", Decoration: "repoExcludes", Pattern: opts.excludeRepos}
+	}
+	return models.ImmutableSelector{Kind: "doublestar", Decoration: "repoMatches", Pattern: opts.repos}
+}
+
+func tagSelector(opts selectorOptions) *models.ImmutableSelector {
+	if opts.excludeTags != "" {
+		return &models.ImmutableSelector{Kind: "doublestar", Decoration: "excludes", Pattern: opts.excludeTags}
+	}
+	return &models.ImmutableSelector{Kind: "doublestar", Decoration: "matches", Pattern: opts.tags}
+}
+
+// findRule returns the immutable rule of the project with the given ID.
+func findRule(projectName, id string) (*models.ImmutableRule, error) {
+	ruleID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid rule ID: %s", id)
+	}
+
+	rules, err := api.ListImmutableRules(projectName)
+	if err != nil {
+		return nil, err
+	}
+	for _, rule := range rules {
+		if rule.ID == ruleID {
+			return rule, nil
+		}
+	}
+	return nil, fmt.Errorf("immutable rule %d not found in project %s", ruleID, projectName)
+}
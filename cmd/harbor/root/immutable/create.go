@@ -0,0 +1,78 @@
+package immutable
+
+import (
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/api"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func CreateImmutableCommand() *cobra.Command {
+	var opts selectorOptions
+	var disabled bool
+
+	cmd := &cobra.Command{
+		Use:   "create <project name>...",
+		Short: "create an immutable tag rule in projects",
+		Long: `Create the same immutable tag rule in each of the given projects. Projects that already
+have an identical rule are left alone, so the command can be rerun to codify rules across projects.
+Patterns use doublestar syntax such as '**', 'release-*' or '{v1,v2}.*'.`,
+		Example: `  harbor immutable create library --tags 'release-*'
+  harbor immutable create dev prod --repos 'team-a/**' --exclude-tags 'latest'`,
+		Args: cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			for _, projectName := range args {
+				err := createRule(projectName, opts, disabled)
+				if err != nil {
+					log.Errorf("failed to create immutable rule in project %s: %v", projectName, err)
+				}
+			}
+		},
+	}
+
+	addSelectorFlags(cmd, &opts)
+	cmd.Flags().BoolVar(&disabled, "disabled", false, "Create the rule disabled")
+
+	return cmd
+}
+
+func createRule(projectName string, opts selectorOptions, disabled bool) error {
+	rule := &models.ImmutableRule{
+		Action:         "immutable",
+		Template:       "immutable_template",
+		Disabled:       disabled,
+		TagSelectors:   []*models.ImmutableSelector{tagSelector(opts)},
+		ScopeSelectors: map[string][]models.ImmutableSelector{"repository": {repoSelector(opts)}},
+	}
+
+	existing, err := api.ListImmutableRules(projectName)
+	if err != nil {
+		return err
+	}
+	for _, r := range existing {
+		if sameSelectors(r, rule) {
+			log.Infof("project %s already has immutable rule %d", projectName, r.ID)
+			return nil
+		}
+	}
+
+	return api.CreateImmutableRule(projectName, rule)
+}
+
+func sameSelectors(a, b *models.ImmutableRule) bool {
+	repoA, repoB := a.ScopeSelectors["repository"], b.ScopeSelectors["repository"]
+	if len(repoA) != len(repoB) || len(a.TagSelectors) != len(b.TagSelectors) {
+		return false
+	}
+	for i := range repoA {
+		if repoA[i].Decoration != repoB[i].Decoration || repoA[i].Pattern != repoB[i].Pattern {
+			return false
+		}
+	}
+	for i := range a.TagSelectors {
+		if a.TagSelectors[i].Decoration != b.TagSelectors[i].Decoration || a.TagSelectors[i].Pattern != b.TagSelectors[i].Pattern {
+			return false
+		}
+	}
+	return true
+}
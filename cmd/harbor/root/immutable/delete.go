@@ -0,0 +1,30 @@
+package immutable
+
+import (
+	"github.com/goharbor/harbor-cli/pkg/api"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func DeleteImmutableCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delete <project name> <rule ID>",
+		Short:   "delete an immutable tag rule",
+		Example: `harbor immutable delete library 3`,
+		Args:    cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			rule, err := findRule(args[0], args[1])
+			if err != nil {
+				log.Errorf("failed to delete immutable rule: %v", err)
+				return
+			}
+
+			err = api.DeleteImmutableRule(args[0], rule.ID)
+			if err != nil {
+				log.Errorf("failed to delete immutable rule: %v", err)
+			}
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,39 @@
+package immutable
+
+import (
+	"github.com/goharbor/harbor-cli/pkg/api"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func EnableImmutableCommand() *cobra.Command {
+	return setDisabledCommand("enable", false)
+}
+
+func DisableImmutableCommand() *cobra.Command {
+	return setDisabledCommand("disable", true)
+}
+
+func setDisabledCommand(action string, disabled bool) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     action + " <project name> <rule ID>",
+		Short:   action + " an immutable tag rule",
+		Example: `harbor immutable ` + action + ` library 3`,
+		Args:    cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			rule, err := findRule(args[0], args[1])
+			if err != nil {
+				log.Errorf("failed to %s immutable rule: %v", action, err)
+				return
+			}
+
+			rule.Disabled = disabled
+			err = api.UpdateImmutableRule(args[0], rule)
+			if err != nil {
+				log.Errorf("failed to %s immutable rule: %v", action, err)
+			}
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,46 @@
+package immutable
+
+import (
+	"github.com/goharbor/harbor-cli/pkg/api"
+	"github.com/goharbor/harbor-cli/pkg/prompt"
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	"github.com/goharbor/harbor-cli/pkg/views/immutable/list"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func ListImmutableCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list [project name]",
+		Short:   "list immutable tag rules of a project",
+		Example: `harbor immutable list library`,
+		Args:    cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			var projectName string
+			if len(args) > 0 {
+				projectName = args[0]
+			} else {
+				projectName = prompt.GetProjectNameFromUser()
+			}
+
+			rules, err := api.ListImmutableRules(projectName)
+			if err != nil {
+				log.Errorf("failed to list immutable rules: %v", err)
+				return
+			}
+
+			FormatFlag := viper.GetString("output-format")
+			if FormatFlag != "" {
+				err = utils.PrintFormat(rules, FormatFlag)
+				if err != nil {
+					log.Error(err)
+				}
+			} else {
+				list.ListRules(rules)
+			}
+		},
+	}
+
+	return cmd
+}
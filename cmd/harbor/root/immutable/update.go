@@ -0,0 +1,43 @@
+package immutable
+
+import (
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/api"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func UpdateImmutableCommand() *cobra.Command {
+	var opts selectorOptions
+
+	cmd := &cobra.Command{
+		Use:     "update <project name> <rule ID>",
+		Short:   "update the selectors of an immutable tag rule",
+		Example: `harbor immutable update library 3 --tags 'v*'`,
+		Args:    cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			rule, err := findRule(args[0], args[1])
+			if err != nil {
+				log.Errorf("failed to update immutable rule: %v", err)
+				return
+			}
+
+			flags := cmd.Flags()
+			if flags.Changed("repos") || flags.Changed("exclude-repos") {
+				rule.ScopeSelectors = map[string][]models.ImmutableSelector{"repository": {repoSelector(opts)}}
+			}
+			if flags.Changed("tags") || flags.Changed("exclude-tags") {
+				rule.TagSelectors = []*models.ImmutableSelector{tagSelector(opts)}
+			}
+
+			err = api.UpdateImmutableRule(args[0], rule)
+			if err != nil {
+				log.Errorf("failed to update immutable rule: %v", err)
+			}
+		},
+	}
+
+	addSelectorFlags(cmd, &opts)
+
+	return cmd
+}
@@ -13,11 +13,14 @@ func Project() *cobra.Command {
 	}
 	cmd.AddCommand(
 		CreateProjectCommand(),
+		UpdateProjectCommand(),
 		DeleteProjectCommand(),
 		ListProjectCommand(),
 		ViewCommand(),
 		LogsProjectCommmand(),
 		SearchProjectCommand(),
+		MemberCommand(),
+		UsageProjectCommand(),
 	)
 
 	return cmd
@@ -0,0 +1,304 @@
+package project
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/api"
+	"github.com/goharbor/harbor-cli/pkg/prompt"
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	"github.com/goharbor/harbor-cli/pkg/views/member/list"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// memberRoles maps the role names accepted on the command line to their Harbor IDs.
+var memberRoles = map[string]int64{
+	"projectAdmin": 1,
+	"developer":    2,
+	"guest":        3,
+	"maintainer":   4,
+	"limitedGuest": 5,
+}
+
+const roleNames = "projectAdmin, maintainer, developer, guest or limitedGuest"
+
+func MemberCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "member",
+		Short: "Manage members of a project",
+		Example: `  harbor project member list library
+  harbor project member add library alice --role developer
+  harbor project member add library --from-file team.txt`,
+	}
+
+	cmd.AddCommand(
+		ListMemberCommand(),
+		AddMemberCommand(),
+		UpdateMemberCommand(),
+		RemoveMemberCommand(),
+	)
+
+	return cmd
+}
+
+func ListMemberCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list [project name]",
+		Short: "list members of a project",
+		Args:  cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			var projectName string
+			if len(args) > 0 {
+				projectName = args[0]
+			} else {
+				projectName = prompt.GetProjectNameFromUser()
+			}
+
+			members, err := api.ListMembers(projectName)
+			if err != nil {
+				log.Errorf("failed to list members: %v", err)
+				return
+			}
+
+			FormatFlag := viper.GetString("output-format")
+			if FormatFlag != "" {
+				err = utils.PrintFormat(members, FormatFlag)
+				if err != nil {
+					log.Error(err)
+				}
+			} else {
+				list.ListMembers(members)
+			}
+		},
+	}
+
+	return cmd
+}
+
+func AddMemberCommand() *cobra.Command {
+	var role, fromFile string
+	var group bool
+
+	cmd := &cobra.Command{
+		Use:   "add <project name> [user or group name]",
+		Short: "add a user or group to a project",
+		Long: `Add a user or group to a project with a role: ` + roleNames + `.
+
+With --from-file every line of the file is "<name> <role> [user|group]"; members already
+in the project get their role updated, so a team roster can be applied repeatedly.
+Blank lines and lines starting with # are ignored.`,
+		Example: `  harbor project member add library alice --role developer
+  harbor project member add library devops --group --role maintainer
+  harbor project member add library --from-file team.txt`,
+		Args: cobra.RangeArgs(1, 2),
+		Run: func(cmd *cobra.Command, args []string) {
+			var err error
+			projectName := args[0]
+
+			if fromFile != "" {
+				err = applyRoster(projectName, fromFile)
+			} else if len(args) < 2 {
+				err = fmt.Errorf("a user or group name is required without --from-file")
+			} else {
+				err = addMember(projectName, args[1], role, group)
+			}
+
+			if err != nil {
+				log.Errorf("failed to add member: %v", err)
+			}
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&role, "role", "r", "developer", "Role of the member: "+roleNames)
+	flags.BoolVarP(&group, "group", "g", false, "The member is a user group instead of a user")
+	flags.StringVarP(&fromFile, "from-file", "f", "", "File with one \"<name> <role> [user|group]\" per line")
+
+	return cmd
+}
+
+func UpdateMemberCommand() *cobra.Command {
+	var role string
+	var group bool
+
+	cmd := &cobra.Command{
+		Use:     "update <project name> <user or group name>",
+		Short:   "change the role of a project member",
+		Example: `harbor project member update library alice --role maintainer`,
+		Args:    cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			roleID, err := getRoleID(role)
+			if err != nil {
+				log.Errorf("failed to update member: %v", err)
+				return
+			}
+
+			m, err := findMember(args[0], args[1], group)
+			if err != nil {
+				log.Errorf("failed to update member: %v", err)
+				return
+			}
+
+			err = api.UpdateMemberRole(args[0], m.ID, roleID)
+			if err != nil {
+				log.Errorf("failed to update member: %v", err)
+			}
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&role, "role", "r", "", "Role of the member: "+roleNames)
+	flags.BoolVarP(&group, "group", "g", false, "The member is a user group instead of a user")
+	_ = cmd.MarkFlagRequired("role")
+
+	return cmd
+}
+
+func RemoveMemberCommand() *cobra.Command {
+	var group bool
+
+	cmd := &cobra.Command{
+		Use:     "remove <project name> <user or group name>",
+		Short:   "remove a member from a project",
+		Example: `harbor project member remove library alice`,
+		Args:    cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			m, err := findMember(args[0], args[1], group)
+			if err != nil {
+				log.Errorf("failed to remove member: %v", err)
+				return
+			}
+
+			err = api.RemoveMember(args[0], m.ID)
+			if err != nil {
+				log.Errorf("failed to remove member: %v", err)
+			}
+		},
+	}
+
+	cmd.Flags().BoolVarP(&group, "group", "g", false, "The member is a user group instead of a user")
+
+	return cmd
+}
+
+func getRoleID(role string) (int64, error) {
+	for name, id := range memberRoles {
+		if strings.EqualFold(name, role) {
+			return id, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown role %q, must be one of %s", role, roleNames)
+}
+
+func addMember(projectName, name, role string, group bool) error {
+	roleID, err := getRoleID(role)
+	if err != nil {
+		return err
+	}
+
+	projectMember := &models.ProjectMember{RoleID: roleID}
+	if group {
+		groupID, err := api.GetUserGroupIdByName(name)
+		if err != nil {
+			return err
+		}
+		projectMember.MemberGroup = &models.UserGroup{ID: groupID, GroupName: name}
+	} else {
+		userID, err := api.GetUsersIdByName(name)
+		if err != nil {
+			return err
+		}
+		projectMember.MemberUser = &models.UserEntity{UserID: userID, Username: name}
+	}
+
+	return api.AddMember(projectName, projectMember)
+}
+
+// findMember returns the member of the project with the given name.
+func findMember(projectName, name string, group bool) (*models.ProjectMemberEntity, error) {
+	members, err := api.ListMembers(projectName)
+	if err != nil {
+		return nil, err
+	}
+	return findMemberIn(members, name, group)
+}
+
+func findMemberIn(members []*models.ProjectMemberEntity, name string, group bool) (*models.ProjectMemberEntity, error) {
+	entityType := "u"
+	if group {
+		entityType = "g"
+	}
+	for _, m := range members {
+		if m.EntityName == name && m.EntityType == entityType {
+			return m, nil
+		}
+	}
+	return nil, fmt.Errorf("%s is not a member of the project", name)
+}
+
+// applyRoster adds the members listed in file to the project, updating the
+// role of those already in it.
+func applyRoster(projectName, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %v", path, err)
+	}
+	defer file.Close()
+
+	members, err := api.ListMembers(projectName)
+	if err != nil {
+		return err
+	}
+
+	var applied, failed int
+	scanner := bufio.NewScanner(file)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) < 2 || len(fields) > 3 || (len(fields) == 3 && fields[2] != "user" && fields[2] != "group") {
+			log.Errorf("line %d: expected \"<name> <role> [user|group]\", got %q", lineNumber, line)
+			failed++
+			continue
+		}
+		name, role := fields[0], fields[1]
+		group := len(fields) == 3 && fields[2] == "group"
+
+		if existing, err := findMemberIn(members, name, group); err == nil {
+			var roleID int64
+			roleID, err = getRoleID(role)
+			if err == nil && roleID != existing.RoleID {
+				err = api.UpdateMemberRole(projectName, existing.ID, roleID)
+			}
+			if err != nil {
+				log.Errorf("line %d: %v", lineNumber, err)
+				failed++
+				continue
+			}
+		} else if err := addMember(projectName, name, role, group); err != nil {
+			log.Errorf("line %d: %v", lineNumber, err)
+			failed++
+			continue
+		}
+		applied++
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read %s: %v", path, err)
+	}
+
+	fmt.Printf("%d member(s) applied, %d failed\n", applied, failed)
+	if failed > 0 {
+		return fmt.Errorf("%d member(s) could not be applied", failed)
+	}
+	return nil
+}
@@ -0,0 +1,185 @@
+package project
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/api"
+	"github.com/goharbor/harbor-cli/pkg/prompt"
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	"github.com/goharbor/harbor-cli/pkg/views/project/update"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+// UpdateProjectCommand creates a new `harbor project update` command
+func UpdateProjectCommand() *cobra.Command {
+	var opts update.UpdateView
+
+	cmd := &cobra.Command{
+		Use:   "update [project name]",
+		Short: "update project settings",
+		Long: `Update the access level, vulnerability scanning, content trust and storage quota of a project.
+Only the settings given as flags are changed; without flags an interactive form is shown.`,
+		Example: `  harbor project update library --public=false --auto-scan
+  harbor project update library --prevent-vul --severity high --storage-limit 50GiB`,
+		Args: cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			var projectName string
+			if len(args) > 0 {
+				projectName = args[0]
+			} else {
+				projectName = prompt.GetProjectNameFromUser()
+			}
+
+			err := updateProject(cmd, projectName, opts)
+			if err != nil {
+				log.Errorf("failed to update project: %v", err)
+			}
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.BoolVar(&opts.Public, "public", false, "Project is public or private")
+	flags.BoolVar(&opts.AutoScan, "auto-scan", false, "Automatically scan images when they are pushed")
+	flags.BoolVar(&opts.PreventVul, "prevent-vul", false, "Prevent images with vulnerabilities from being pulled")
+	flags.StringVar(&opts.Severity, "severity", "", "Minimum severity of vulnerabilities that prevents pulling: none, low, medium, high or critical")
+	flags.BoolVar(&opts.ContentTrust, "content-trust", false, "Only allow pulling images signed with Notary")
+	flags.BoolVar(&opts.ContentTrustCosign, "content-trust-cosign", false, "Only allow pulling images signed with cosign")
+	flags.BoolVar(&opts.ReuseSysCVEAllowlist, "reuse-sys-cve-allowlist", false, "Use the system CVE allowlist instead of the project one")
+	flags.StringVar(&opts.StorageLimit, "storage-limit", "", "Storage quota of the project such as 10GiB, -1 for unlimited")
+
+	return cmd
+}
+
+func updateProject(cmd *cobra.Command, projectName string, flagOpts update.UpdateView) error {
+	response, err := api.GetProject(projectName)
+	if err != nil {
+		return err
+	}
+	project := response.Payload
+
+	current := currentSettings(project)
+	opts := current
+
+	flags := cmd.Flags()
+	changed := false
+	for name, apply := range map[string]func(){
+		"public":                  func() { opts.Public = flagOpts.Public },
+		"auto-scan":               func() { opts.AutoScan = flagOpts.AutoScan },
+		"prevent-vul":             func() { opts.PreventVul = flagOpts.PreventVul },
+		"severity":                func() { opts.Severity = flagOpts.Severity },
+		"content-trust":           func() { opts.ContentTrust = flagOpts.ContentTrust },
+		"content-trust-cosign":    func() { opts.ContentTrustCosign = flagOpts.ContentTrustCosign },
+		"reuse-sys-cve-allowlist": func() { opts.ReuseSysCVEAllowlist = flagOpts.ReuseSysCVEAllowlist },
+		"storage-limit":           func() { opts.StorageLimit = flagOpts.StorageLimit },
+	} {
+		if flags.Changed(name) {
+			apply()
+			changed = true
+		}
+	}
+
+	// The storage limit is read from the project summary, which project
+	// admins can read, and only when it may change.
+	hard := int64(-1)
+	if !changed || flags.Changed("storage-limit") {
+		summary, err := api.GetProjectSummary(projectName)
+		if err != nil {
+			return err
+		}
+		hard = storageLimit(summary)
+		current.StorageLimit = formatStorageLimit(hard)
+		if !flags.Changed("storage-limit") {
+			opts.StorageLimit = current.StorageLimit
+		}
+	}
+	if !changed {
+		update.UpdateProjectView(&opts)
+	}
+
+	if opts.Severity != "" && !slices.Contains(update.Severities, opts.Severity) {
+		return fmt.Errorf("invalid severity %q, must be one of %v", opts.Severity, update.Severities)
+	}
+
+	limit := opts.StorageLimit
+	opts.StorageLimit = current.StorageLimit
+	if opts != current {
+		if err := api.UpdateProject(projectName, opts); err != nil {
+			return err
+		}
+	}
+
+	storage, storageChanged, err := storageQuotaChange(current.StorageLimit, limit, hard)
+	if err != nil {
+		return err
+	}
+	if storageChanged {
+		quota, err := api.GetProjectQuota(int64(project.ProjectID))
+		if err != nil {
+			return err
+		}
+		if err := api.UpdateStorageQuota(quota.ID, storage); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// storageQuotaChange returns the storage quota to set when limit differs
+// from the displayed current limit and from the hard limit in bytes. The
+// displayed limit is rounded by utils.FormatSize, so an untouched field
+// must not be parsed back and sent.
+func storageQuotaChange(current, limit string, hard int64) (int64, bool, error) {
+	if limit == current {
+		return 0, false, nil
+	}
+	storage, err := utils.ParseStorageSize(limit)
+	if err != nil {
+		return 0, false, err
+	}
+	return storage, storage != hard, nil
+}
+
+// storageLimit returns the storage limit in bytes of a project summary, -1
+// when the project has no limit.
+func storageLimit(summary *models.ProjectSummary) int64 {
+	if summary.Quota == nil {
+		return -1
+	}
+	if storage, ok := summary.Quota.Hard["storage"]; ok && storage >= 0 {
+		return storage
+	}
+	return -1
+}
+
+func formatStorageLimit(storage int64) string {
+	if storage < 0 {
+		return "-1"
+	}
+	return utils.FormatSize(storage)
+}
+
+// currentSettings returns the settings of the project as an update.UpdateView.
+func currentSettings(project *models.Project) update.UpdateView {
+	isTrue := func(value *string) bool {
+		return value != nil && *value == "true"
+	}
+
+	settings := update.UpdateView{StorageLimit: "-1"}
+	if metadata := project.Metadata; metadata != nil {
+		settings.Public = metadata.Public == "true"
+		settings.AutoScan = isTrue(metadata.AutoScan)
+		settings.PreventVul = isTrue(metadata.PreventVul)
+		settings.ContentTrust = isTrue(metadata.EnableContentTrust)
+		settings.ContentTrustCosign = isTrue(metadata.EnableContentTrustCosign)
+		settings.ReuseSysCVEAllowlist = isTrue(metadata.ReuseSysCVEAllowlist)
+		if metadata.Severity != nil {
+			settings.Severity = *metadata.Severity
+		}
+	}
+
+	return settings
+}
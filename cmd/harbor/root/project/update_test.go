@@ -0,0 +1,76 @@
+package project
+
+import (
+	"testing"
+
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStorageQuotaChange(t *testing.T) {
+	// 1000000000 bytes is displayed as 953.67MiB, which parses back to
+	// 999995473 bytes.
+	tests := []struct {
+		name        string
+		current     string
+		limit       string
+		hard        int64
+		wantStorage int64
+		wantChanged bool
+		wantErr     bool
+	}{
+		{name: "untouched", current: "953.67MiB", limit: "953.67MiB", hard: 1000000000},
+		{name: "same size in other unit", current: "10240.00MiB", limit: "10GiB", hard: 10 << 30},
+		{name: "new size", current: "953.67MiB", limit: "2GiB", hard: 1000000000, wantStorage: 2 << 30, wantChanged: true},
+		{name: "unlimited", current: "953.67MiB", limit: "-1", hard: 1000000000, wantStorage: -1, wantChanged: true},
+		{name: "still unlimited", current: "-1", limit: "-1", hard: -1},
+		{name: "limit an unlimited project", current: "-1", limit: "500MiB", hard: -1, wantStorage: 500 << 20, wantChanged: true},
+		{name: "invalid", current: "-1", limit: "lots", hard: -1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storage, changed, err := storageQuotaChange(tt.current, tt.limit, tt.hard)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantChanged, changed)
+			if tt.wantChanged {
+				assert.Equal(t, tt.wantStorage, storage)
+			}
+		})
+	}
+}
+
+func TestStorageLimit(t *testing.T) {
+	tests := []struct {
+		name    string
+		summary *models.ProjectSummary
+		want    int64
+		display string
+	}{
+		{
+			name:    "limited",
+			summary: &models.ProjectSummary{Quota: &models.ProjectSummaryQuota{Hard: models.ResourceList{"storage": 10 << 30}}},
+			want:    10 << 30,
+			display: "10240.00MiB",
+		},
+		{
+			name:    "unlimited",
+			summary: &models.ProjectSummary{Quota: &models.ProjectSummaryQuota{Hard: models.ResourceList{"storage": -1}}},
+			want:    -1,
+			display: "-1",
+		},
+		{name: "quota disabled", summary: &models.ProjectSummary{}, want: -1, display: "-1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := storageLimit(tt.summary)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.display, formatStorageLimit(got))
+		})
+	}
+}
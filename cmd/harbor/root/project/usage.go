@@ -0,0 +1,145 @@
+package project
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/api"
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	"github.com/goharbor/harbor-cli/pkg/views/project/usage"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func UsageProjectCommand() *cobra.Command {
+	var repositories bool
+	var top int
+
+	cmd := &cobra.Command{
+		Use:   "usage [project name...]",
+		Short: "report storage usage per project and repository",
+		Long: `Report the storage consumed by projects, largest first, to find what fills the disk.
+Without arguments every project is included. The artifact size adds up the artifacts of each
+repository, counting layers shared between artifacts more than once, next to the deduplicated
+usage Harbor accounts against the project quota.`,
+		Example: `  harbor project usage --top 10
+  harbor project usage library --repositories`,
+		Run: func(cmd *cobra.Command, args []string) {
+			projects, err := collectUsage(args, repositories)
+			if err != nil {
+				log.Errorf("failed to get usage: %v", err)
+				return
+			}
+			if top > 0 && len(projects) > top {
+				projects = projects[:top]
+			}
+
+			FormatFlag := viper.GetString("output-format")
+			if FormatFlag != "" {
+				err = utils.PrintFormat(projects, FormatFlag)
+				if err != nil {
+					log.Error(err)
+				}
+			} else if repositories {
+				usage.ListRepositoryUsage(projects)
+			} else {
+				usage.ListProjectUsage(projects)
+			}
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.BoolVarP(&repositories, "repositories", "r", false, "Break the usage down per repository")
+	flags.IntVar(&top, "top", 0, "Only show the given number of largest projects")
+
+	return cmd
+}
+
+// collectUsage sizes the given projects, or all projects when none are
+// given, sorted by artifact size.
+func collectUsage(projectNames []string, withRepositories bool) ([]*usage.ProjectUsage, error) {
+	var projects []*models.Project
+	if len(projectNames) == 0 {
+		listFlags := api.ListFlags{Page: 1, PageSize: 100}
+		for {
+			response, err := api.ListAllProjects(listFlags)
+			if err != nil {
+				return nil, err
+			}
+			projects = append(projects, response.Payload...)
+			if int64(len(response.Payload)) < listFlags.PageSize {
+				break
+			}
+			listFlags.Page++
+		}
+	} else {
+		for _, name := range projectNames {
+			response, err := api.GetProject(name)
+			if err != nil {
+				return nil, err
+			}
+			projects = append(projects, response.Payload)
+		}
+	}
+
+	var result []*usage.ProjectUsage
+	for _, project := range projects {
+		projectUsage := &usage.ProjectUsage{Name: project.Name}
+		// The quota API is for system admins only, the summary carries the
+		// quota of a project to its members too.
+		summary, err := api.GetProjectSummary(project.Name)
+		if err != nil {
+			log.Warnf("quota of project %s is unknown: %v", project.Name, err)
+		} else {
+			projectUsage.QuotaUsed, projectUsage.QuotaLimit = summaryQuota(summary)
+		}
+
+		repos, err := api.ListAllRepositories(project.Name)
+		if err != nil {
+			return nil, err
+		}
+		projectUsage.Repositories = len(repos)
+		for _, repo := range repos {
+			repoName := strings.TrimPrefix(repo.Name, project.Name+"/")
+			artifacts, err := api.ListAllArtifacts(project.Name, repoName, api.ListFlags{})
+			if err != nil {
+				return nil, err
+			}
+
+			repoUsage := &usage.RepositoryUsage{Name: repo.Name, Artifacts: len(artifacts)}
+			for _, artifact := range artifacts {
+				repoUsage.Size += artifact.Size
+			}
+			projectUsage.Artifacts += repoUsage.Artifacts
+			projectUsage.Size += repoUsage.Size
+			if withRepositories {
+				projectUsage.Details = append(projectUsage.Details, repoUsage)
+			}
+		}
+		sort.Slice(projectUsage.Details, func(i, j int) bool {
+			return projectUsage.Details[i].Size > projectUsage.Details[j].Size
+		})
+
+		result = append(result, projectUsage)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Size > result[j].Size
+	})
+	return result, nil
+}
+
+// summaryQuota returns the used and hard storage of a project summary, with
+// a limit of -1 when the project is unlimited.
+func summaryQuota(summary *models.ProjectSummary) (*int64, *int64) {
+	used, limit := int64(0), int64(-1)
+	if summary.Quota != nil {
+		used = summary.Quota.Used["storage"]
+		if hard, ok := summary.Quota.Hard["storage"]; ok {
+			limit = hard
+		}
+	}
+	return &used, &limit
+}
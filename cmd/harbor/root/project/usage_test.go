@@ -0,0 +1,45 @@
+package project
+
+import (
+	"testing"
+
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSummaryQuota(t *testing.T) {
+	tests := []struct {
+		name      string
+		summary   *models.ProjectSummary
+		wantUsed  int64
+		wantLimit int64
+	}{
+		{
+			name: "limited",
+			summary: &models.ProjectSummary{Quota: &models.ProjectSummaryQuota{
+				Hard: models.ResourceList{"storage": 10 << 30},
+				Used: models.ResourceList{"storage": 512 << 20},
+			}},
+			wantUsed:  512 << 20,
+			wantLimit: 10 << 30,
+		},
+		{
+			name: "unlimited",
+			summary: &models.ProjectSummary{Quota: &models.ProjectSummaryQuota{
+				Hard: models.ResourceList{"storage": -1},
+				Used: models.ResourceList{"storage": 2048},
+			}},
+			wantUsed:  2048,
+			wantLimit: -1,
+		},
+		{name: "quota disabled", summary: &models.ProjectSummary{}, wantLimit: -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			used, limit := summaryQuota(tt.summary)
+			assert.Equal(t, tt.wantUsed, *used)
+			assert.Equal(t, tt.wantLimit, *limit)
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package quota
+
+import (
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/api"
+	"github.com/spf13/cobra"
+)
+
+func Quota() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "quota",
+		Short: "Manage project storage quotas",
+		Long:  `List, view and update the storage quotas of projects`,
+		Example: `  harbor quota list
+  harbor quota update library --storage 50GiB`,
+	}
+	cmd.AddCommand(
+		ListQuotaCommand(),
+		ViewQuotaCommand(),
+		UpdateQuotaCommand(),
+	)
+
+	return cmd
+}
+
+// getQuota returns the quota of the project with the given name or ID.
+func getQuota(projectNameOrID string) (*models.Quota, error) {
+	project, err := api.GetProject(projectNameOrID)
+	if err != nil {
+		return nil, err
+	}
+	return api.GetProjectQuota(int64(project.Payload.ProjectID))
+}
@@ -0,0 +1,53 @@
+package quota
+
+import (
+	"github.com/goharbor/harbor-cli/pkg/api"
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	"github.com/goharbor/harbor-cli/pkg/views/quota/list"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var sortFields = map[string]string{
+	"used":  "-used.storage",
+	"limit": "-hard.storage",
+}
+
+func ListQuotaCommand() *cobra.Command {
+	var sortBy string
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "list project quotas",
+		Example: `harbor quota list --sort used`,
+		Args:    cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			sort, ok := sortFields[sortBy]
+			if !ok {
+				log.Errorf("invalid sort %q, must be used or limit", sortBy)
+				return
+			}
+
+			quotas, err := api.ListQuotas(sort)
+			if err != nil {
+				log.Errorf("failed to list quotas: %v", err)
+				return
+			}
+
+			FormatFlag := viper.GetString("output-format")
+			if FormatFlag != "" {
+				err = utils.PrintFormat(quotas, FormatFlag)
+				if err != nil {
+					log.Error(err)
+				}
+			} else {
+				list.ListQuotas(quotas)
+			}
+		},
+	}
+
+	cmd.Flags().StringVar(&sortBy, "sort", "used", "Sort by storage used or limit, largest first")
+
+	return cmd
+}
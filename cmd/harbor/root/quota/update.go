@@ -0,0 +1,43 @@
+package quota
+
+import (
+	"github.com/goharbor/harbor-cli/pkg/api"
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func UpdateQuotaCommand() *cobra.Command {
+	var storage string
+
+	cmd := &cobra.Command{
+		Use:   "update <project name or ID>",
+		Short: "update the storage quota of a project",
+		Example: `  harbor quota update library --storage 50GiB
+  harbor quota update library --storage -1`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			limit, err := utils.ParseStorageSize(storage)
+			if err != nil {
+				log.Errorf("failed to update quota: %v", err)
+				return
+			}
+
+			quota, err := getQuota(args[0])
+			if err != nil {
+				log.Errorf("failed to update quota: %v", err)
+				return
+			}
+
+			err = api.UpdateStorageQuota(quota.ID, limit)
+			if err != nil {
+				log.Errorf("failed to update quota: %v", err)
+			}
+		},
+	}
+
+	cmd.Flags().StringVar(&storage, "storage", "", "Storage limit such as 10GiB, -1 for unlimited")
+	_ = cmd.MarkFlagRequired("storage")
+
+	return cmd
+}
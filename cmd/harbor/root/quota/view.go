@@ -0,0 +1,46 @@
+package quota
+
+import (
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/prompt"
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	"github.com/goharbor/harbor-cli/pkg/views/quota/list"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func ViewQuotaCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "view [project name or ID]",
+		Short:   "get the quota of a project",
+		Example: `harbor quota view library`,
+		Args:    cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			var projectName string
+			if len(args) > 0 {
+				projectName = args[0]
+			} else {
+				projectName = prompt.GetProjectNameFromUser()
+			}
+
+			quota, err := getQuota(projectName)
+			if err != nil {
+				log.Errorf("failed to get quota: %v", err)
+				return
+			}
+
+			FormatFlag := viper.GetString("output-format")
+			if FormatFlag != "" {
+				err = utils.PrintFormat(quota, FormatFlag)
+				if err != nil {
+					log.Error(err)
+				}
+			} else {
+				list.ListQuotas([]*models.Quota{quota})
+			}
+		},
+	}
+
+	return cmd
+}
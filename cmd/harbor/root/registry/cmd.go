@@ -17,7 +17,21 @@ func Registry() *cobra.Command {
 		DeleteRegistryCommand(),
 		ListRegistryCommand(),
 		UpdateRegistryCommand(),
+		PingRegistryCommand(),
+		ProvidersCommand(),
 	)
 
 	return cmd
 }
+
+// registryFlags are the flags setting the fields of a registry.
+var registryFlags = []string{
+	"name",
+	"type",
+	"url",
+	"description",
+	"insecure",
+	"credential-access-key",
+	"credential-access-secret",
+	"credential-type",
+}
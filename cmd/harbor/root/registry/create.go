@@ -1,6 +1,7 @@
 package registry
 
 import (
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
 	"github.com/goharbor/harbor-cli/pkg/api"
 	"github.com/goharbor/harbor-cli/pkg/views/registry/create"
 	log "github.com/sirupsen/logrus"
@@ -9,12 +10,16 @@ import (
 
 func CreateRegistryCommand() *cobra.Command {
 	var opts api.CreateRegView
+	var skipPing bool
 
 	cmd := &cobra.Command{
-		Use:     "create",
-		Short:   "create registry",
-		Example: "harbor registry create",
-		Args:    cobra.NoArgs,
+		Use:   "create",
+		Short: "create registry",
+		Long: `Create a registry endpoint. The settings missing from --name, --type and --url are
+asked for interactively. Run 'harbor registry providers' for the supported types.`,
+		Example: `  harbor registry create
+  harbor registry create --name dockerhub --type docker-hub --url https://hub.docker.com --credential-access-key user --credential-access-secret s3cr3t`,
+		Args: cobra.NoArgs,
 		Run: func(cmd *cobra.Command, args []string) {
 			var err error
 			createView := &api.CreateRegView{
@@ -30,10 +35,25 @@ func CreateRegistryCommand() *cobra.Command {
 				Insecure: opts.Insecure,
 			}
 
-			if opts.Name != "" && opts.Type != "" && opts.URL != "" {
-				err = api.CreateRegistry(opts)
-			} else {
-				err = createRegistryView(createView)
+			if opts.Name == "" || opts.Type == "" || opts.URL == "" {
+				create.CreateRegistryView(createView)
+			} else if err = checkProvider(opts.Type); err != nil {
+				log.Errorf("failed to create registry: %v", err)
+				return
+			}
+
+			if !skipPing {
+				err = checkRegistry(&models.RegistryPing{
+					Type:           &createView.Type,
+					URL:            &createView.URL,
+					Insecure:       &createView.Insecure,
+					AccessKey:      &createView.Credential.AccessKey,
+					AccessSecret:   &createView.Credential.AccessSecret,
+					CredentialType: &createView.Credential.Type,
+				})
+			}
+			if err == nil {
+				err = api.CreateRegistry(*createView)
 			}
 
 			if err != nil {
@@ -43,28 +63,28 @@ func CreateRegistryCommand() *cobra.Command {
 	}
 
 	flags := cmd.Flags()
-	flags.StringVarP(&opts.Name, "name", "", "", "Name of the registry")
-	flags.StringVarP(&opts.Type, "type", "", "", "Type of the registry")
-	flags.StringVarP(&opts.URL, "url", "", "", "Registry endpoint URL")
-	flags.StringVarP(&opts.Description, "description", "", "", "Description of the registry")
+	flags.StringVarP(&opts.Name, "name", "n", "", "Name of the registry")
+	flags.StringVarP(&opts.Type, "type", "t", "", "Type of the registry")
+	flags.StringVarP(&opts.URL, "url", "u", "", "Registry endpoint URL")
+	flags.StringVarP(&opts.Description, "description", "d", "", "Description of the registry")
 	flags.BoolVarP(
 		&opts.Insecure,
 		"insecure",
-		"",
+		"i",
 		true,
 		"Whether Harbor will verify the server certificate",
 	)
 	flags.StringVarP(
 		&opts.Credential.AccessKey,
 		"credential-access-key",
-		"",
+		"k",
 		"",
 		"Access key, e.g. user name when credential type is 'basic'",
 	)
 	flags.StringVarP(
 		&opts.Credential.AccessSecret,
 		"credential-access-secret",
-		"",
+		"s",
 		"",
 		"Access secret, e.g. password when credential type is 'basic'",
 	)
@@ -75,15 +95,7 @@ func CreateRegistryCommand() *cobra.Command {
 		"basic",
 		"Credential type, such as 'basic', 'oauth'",
 	)
+	flags.BoolVar(&skipPing, "skip-ping", false, "Create the registry without checking that Harbor can reach it")
 
 	return cmd
 }
-
-func createRegistryView(createView *api.CreateRegView) error {
-	if createView == nil {
-		createView = &api.CreateRegView{}
-	}
-
-	create.CreateRegistryView(createView)
-	return api.CreateRegistry(*createView)
-}
@@ -18,8 +18,11 @@ func DeleteRegistryCommand() *cobra.Command {
 			var err error
 
 			if len(args) > 0 {
-				registryName, _ := api.GetRegistryIdByName(args[0])
-				err = api.DeleteRegistry(registryName)
+				var registryId int64
+				registryId, err = api.GetRegistryIdByName(args[0])
+				if err == nil {
+					err = api.DeleteRegistry(registryId)
+				}
 			} else {
 				registryId := prompt.GetRegistryNameFromUser()
 				err = api.DeleteRegistry(registryId)
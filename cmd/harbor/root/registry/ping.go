@@ -0,0 +1,87 @@
+package registry
+
+import (
+	"fmt"
+
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/api"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func PingRegistryCommand() *cobra.Command {
+	var opts api.CreateRegView
+
+	cmd := &cobra.Command{
+		Use:   "ping [registry name]",
+		Short: "check that Harbor can reach a registry",
+		Long: `Check that Harbor can reach a registry endpoint and log in with its credentials. Given
+a name, the saved registry is checked with the flags overriding its settings; otherwise the
+endpoint given with --url and --type is checked before it is created.`,
+		Example: `  harbor registry ping dockerhub
+  harbor registry ping --url https://registry.example.com --type harbor --credential-access-key robot --credential-access-secret s3cr3t`,
+		Args: cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ping := &models.RegistryPing{}
+			target := opts.URL
+
+			if len(args) > 0 {
+				registryId, err := api.GetRegistryIdByName(args[0])
+				if err != nil {
+					log.Errorf("failed to ping registry: %v", err)
+					return
+				}
+				ping.ID = &registryId
+				target = args[0]
+			} else if opts.URL == "" || opts.Type == "" {
+				log.Errorf("failed to ping registry: a registry name, or --url and --type are required")
+				return
+			}
+
+			flags := cmd.Flags()
+			if flags.Changed("url") {
+				ping.URL = &opts.URL
+			}
+			if flags.Changed("type") {
+				ping.Type = &opts.Type
+			}
+			if flags.Changed("insecure") {
+				ping.Insecure = &opts.Insecure
+			}
+			if flags.Changed("credential-access-key") {
+				ping.AccessKey = &opts.Credential.AccessKey
+			}
+			if flags.Changed("credential-access-secret") {
+				ping.AccessSecret = &opts.Credential.AccessSecret
+			}
+			if flags.Changed("credential-type") || ping.ID == nil {
+				ping.CredentialType = &opts.Credential.Type
+			}
+
+			err := api.PingRegistry(ping)
+			if err != nil {
+				log.Errorf("failed to ping registry %s: %v", target, err)
+				return
+			}
+			log.Infof("Registry %s is reachable", target)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&opts.Type, "type", "", "", "Type of the registry")
+	flags.StringVarP(&opts.URL, "url", "", "", "Registry endpoint URL")
+	flags.BoolVarP(&opts.Insecure, "insecure", "", false, "Do not verify the certificate of the registry")
+	flags.StringVarP(&opts.Credential.AccessKey, "credential-access-key", "", "", "Access key, e.g. user name when credential type is 'basic'")
+	flags.StringVarP(&opts.Credential.AccessSecret, "credential-access-secret", "", "", "Access secret, e.g. password when credential type is 'basic'")
+	flags.StringVarP(&opts.Credential.Type, "credential-type", "", "basic", "Credential type, such as 'basic', 'oauth'")
+
+	return cmd
+}
+
+// checkRegistry pings a registry before it is saved.
+func checkRegistry(ping *models.RegistryPing) error {
+	if err := api.PingRegistry(ping); err != nil {
+		return fmt.Errorf("%v, use --skip-ping to save it anyway", err)
+	}
+	return nil
+}
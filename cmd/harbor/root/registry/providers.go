@@ -0,0 +1,70 @@
+package registry
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/goharbor/harbor-cli/pkg/api"
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	"github.com/goharbor/harbor-cli/pkg/views/registry/providers"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func ProvidersCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "providers",
+		Short: "list the registry provider types",
+		Long: `List the provider types accepted by 'harbor registry create --type', with the access key,
+access secret and endpoint URLs each provider expects.`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			types, err := api.GetRegistryProviders()
+			if err != nil {
+				log.Errorf("failed to list registry providers: %v", err)
+				return
+			}
+			infos, err := api.ListRegistryProviderInfos()
+			if err != nil {
+				log.Errorf("failed to list registry providers: %v", err)
+				return
+			}
+
+			sort.Strings(types)
+			var list []providers.Provider
+			for _, providerType := range types {
+				list = append(list, providers.Provider{
+					Type:                 providerType,
+					RegistryProviderInfo: infos[providerType],
+				})
+			}
+
+			FormatFlag := viper.GetString("output-format")
+			if FormatFlag != "" {
+				err = utils.PrintFormat(list, FormatFlag)
+				if err != nil {
+					log.Error(err)
+				}
+			} else {
+				providers.ListProviders(list)
+			}
+		},
+	}
+
+	return cmd
+}
+
+// checkProvider fails for provider types unknown to Harbor.
+func checkProvider(providerType string) error {
+	types, err := api.GetRegistryProviders()
+	if err != nil {
+		return err
+	}
+	for _, t := range types {
+		if t == providerType {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown registry type %s, run 'harbor registry providers' for the supported types", providerType)
+}
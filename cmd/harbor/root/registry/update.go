@@ -13,11 +13,17 @@ func UpdateRegistryCommand() *cobra.Command {
 	opts := &models.Registry{
 		Credential: &models.RegistryCredential{},
 	}
+	var skipPing bool
 
 	cmd := &cobra.Command{
 		Use:   "update [registry_name]",
 		Short: "update registry",
-		Args:  cobra.MaximumNArgs(1),
+		Long: `Update a registry endpoint. Without any of the registry flags the settings are edited
+interactively, otherwise only the given flags are changed. The type of a registry cannot be
+changed.`,
+		Example: `  harbor registry update dockerhub --credential-access-key robot --credential-access-secret s3cr3t
+  harbor registry update dockerhub --url https://registry.example.com --insecure=false`,
+		Args: cobra.MaximumNArgs(1),
 		Run: func(cmd *cobra.Command, args []string) {
 			var err error
 			var registryId int64
@@ -52,11 +58,19 @@ func UpdateRegistryCommand() *cobra.Command {
 			}
 
 			flags := cmd.Flags()
+			interactive := true
+			for _, name := range registryFlags {
+				if flags.Changed(name) {
+					interactive = false
+				}
+			}
 			if flags.Changed("name") {
 				updateView.Name = opts.Name
 			}
-			if flags.Changed("type") {
-				updateView.Type = opts.Type
+			// Harbor cannot change the provider of a registry.
+			if flags.Changed("type") && opts.Type != existingRegistry.Type {
+				log.Errorf("failed to update registry: the type of registry %s is %s and cannot be changed, create a new registry instead", existingRegistry.Name, existingRegistry.Type)
+				return
 			}
 			if flags.Changed("description") {
 				updateView.Description = opts.Description
@@ -77,7 +91,33 @@ func UpdateRegistryCommand() *cobra.Command {
 				updateView.Credential.Type = opts.Credential.Type
 			}
 
-			update.UpdateRegistryView(updateView)
+			if interactive {
+				update.UpdateRegistryView(updateView)
+			}
+
+			// Harbor does not return the saved secret, it is only sent when changed.
+			if updateView.Credential.AccessSecret == existingRegistry.Credential.AccessSecret {
+				updateView.Credential.AccessSecret = ""
+			}
+
+			if !skipPing {
+				ping := &models.RegistryPing{
+					ID:             &registryId,
+					Type:           &updateView.Type,
+					URL:            &updateView.URL,
+					Insecure:       &updateView.Insecure,
+					AccessKey:      &updateView.Credential.AccessKey,
+					CredentialType: &updateView.Credential.Type,
+				}
+				if updateView.Credential.AccessSecret != "" {
+					ping.AccessSecret = &updateView.Credential.AccessSecret
+				}
+				if err = checkRegistry(ping); err != nil {
+					log.Errorf("failed to update registry: %v", err)
+					return
+				}
+			}
+
 			err = api.UpdateRegistry(updateView, registryId)
 			if err != nil {
 				log.Errorf("failed to update registry: %v", err)
@@ -88,13 +128,14 @@ func UpdateRegistryCommand() *cobra.Command {
 
 	flags := cmd.Flags()
 	flags.StringVarP(&opts.Name, "name", "n", "", "Name of the registry")
-	flags.StringVarP(&opts.Type, "type", "t", "", "Type of the registry")
+	flags.StringVarP(&opts.Type, "type", "t", "", "Type of the registry, which cannot be changed")
 	flags.StringVarP(&opts.URL, "url", "u", "", "Registry endpoint URL")
 	flags.StringVarP(&opts.Description, "description", "d", "", "Description of the registry")
 	flags.BoolVarP(&opts.Insecure, "insecure", "i", false, "Whether or not the certificate will be verified when Harbor tries to access the server")
 	flags.StringVarP(&opts.Credential.AccessKey, "credential-access-key", "k", "", "Access key, e.g. user name when credential type is 'basic'")
 	flags.StringVarP(&opts.Credential.AccessSecret, "credential-access-secret", "s", "", "Access secret, e.g. password when credential type is 'basic'")
 	flags.StringVarP(&opts.Credential.Type, "credential-type", "", "", "Credential type, such as 'basic', 'oauth'")
+	flags.BoolVar(&skipPing, "skip-ping", false, "Update the registry without checking that Harbor can reach it")
 
 	return cmd
 }
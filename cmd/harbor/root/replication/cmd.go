@@ -9,10 +9,20 @@ func Replication() *cobra.Command {
 	var replicationCmd = &cobra.Command{
 		Use:     "replication",
 		Aliases: []string{"repl"},
-		Short:   "",
-		Long:    ``,
+		Short:   "Manage replication between Harbor and other registries",
+		Long: `Manage the policies that replicate artifacts from this Harbor to a remote registry
+(push) or from a remote registry to this Harbor (pull).`,
+		Example: `  harbor replication policy list
+  harbor replication policy create --name sync-prod --mode push --registry backup --repository 'prod/**'
+  harbor replication start sync-prod --watch`,
 	}
-	replicationCmd.AddCommand()
+	replicationCmd.AddCommand(
+		PolicyCommand(),
+		StartCommand(),
+		StopCommand(),
+		ExecutionsCommand(),
+		TasksCommand(),
+	)
 
 	return replicationCmd
 }
@@ -0,0 +1,130 @@
+package replication
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/api"
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	"github.com/goharbor/harbor-cli/pkg/views/replication/executions"
+	"github.com/goharbor/harbor-cli/pkg/views/replication/watch"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func ExecutionsCommand() *cobra.Command {
+	var status string
+
+	cmd := &cobra.Command{
+		Use:   "executions <policy name or ID>",
+		Short: "list the executions of a replication policy",
+		Example: `  harbor replication executions sync-prod
+  harbor replication executions sync-prod --status Failed`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			policy, err := findPolicy(args[0])
+			if err != nil {
+				log.Errorf("failed to list replication executions: %v", err)
+				return
+			}
+
+			list, err := api.ListReplicationExecutions(policy.ID, status)
+			if err != nil {
+				log.Errorf("failed to list replication executions: %v", err)
+				return
+			}
+
+			FormatFlag := viper.GetString("output-format")
+			if FormatFlag != "" {
+				err = utils.PrintFormat(list, FormatFlag)
+				if err != nil {
+					log.Error(err)
+				}
+			} else {
+				executions.ListExecutions(list)
+			}
+		},
+	}
+
+	cmd.Flags().StringVar(&status, "status", "", "Only list executions with the status: InProgress, Succeed, Failed or Stopped")
+
+	return cmd
+}
+
+func addWatchFlags(cmd *cobra.Command, watch *bool, interval *time.Duration) {
+	cmd.Flags().BoolVarP(watch, "watch", "w", false, "Show the progress of the execution until it finishes")
+	cmd.Flags().DurationVar(interval, "interval", 2*time.Second, "Polling interval of --watch")
+}
+
+func fetchExecution(executionID int64) (watch.Snapshot, error) {
+	execution, err := api.GetReplicationExecution(executionID)
+	if err != nil {
+		return watch.Snapshot{}, err
+	}
+
+	// Only the tasks worth showing are fetched, succeeded ones can be many.
+	var tasks []*models.ReplicationTask
+	for _, status := range []string{"InProgress", "Failed"} {
+		list, err := api.ListReplicationTasks(executionID, status)
+		if err != nil {
+			return watch.Snapshot{}, err
+		}
+		tasks = append(tasks, list...)
+	}
+	return watch.Snapshot{Execution: execution, Tasks: tasks}, nil
+}
+
+// watchExecution shows the progress of an execution until it finishes, or
+// waits for it silently when a machine readable output format is requested.
+func watchExecution(executionID int64, interval time.Duration) {
+	var snapshot watch.Snapshot
+	var err error
+
+	FormatFlag := viper.GetString("output-format")
+	if FormatFlag != "" {
+		for {
+			snapshot, err = fetchExecution(executionID)
+			if err != nil || !watch.Running(snapshot.Execution) {
+				break
+			}
+			time.Sleep(interval)
+		}
+	} else {
+		snapshot, err = watch.Watch(func() (watch.Snapshot, error) {
+			return fetchExecution(executionID)
+		}, interval)
+	}
+	if err != nil {
+		log.Errorf("failed to watch replication execution %d: %v", executionID, err)
+		return
+	}
+
+	if FormatFlag != "" {
+		err = utils.PrintFormat(snapshot.Execution, FormatFlag)
+		if err != nil {
+			log.Error(err)
+		}
+	}
+
+	execution := snapshot.Execution
+	switch {
+	case execution == nil:
+		return
+	case watch.Running(execution):
+		log.Infof("Execution %d is still running, follow it with 'harbor replication tasks %d --watch'", executionID, executionID)
+	case execution.Failed > 0:
+		log.Errorf("%s of execution %d failed, see why with 'harbor replication tasks %d --failed-logs'",
+			pluralTasks(execution.Failed), executionID, executionID)
+	case execution.Status != "Succeed":
+		log.Errorf("execution %d %s: %s", executionID, execution.Status, execution.StatusText)
+	}
+}
+
+func pluralTasks(n int64) string {
+	if n == 1 {
+		return "1 task"
+	}
+	return fmt.Sprintf("%d tasks", n)
+}
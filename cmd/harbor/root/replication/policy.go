@@ -0,0 +1,227 @@
+package replication
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/api"
+	"github.com/spf13/cobra"
+)
+
+func PolicyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "policy",
+		Short: "Manage replication policies",
+		Example: `  harbor replication policy list
+  harbor replication policy view sync-prod`,
+	}
+	cmd.AddCommand(
+		ListPolicyCommand(),
+		ViewPolicyCommand(),
+		CreatePolicyCommand(),
+		UpdatePolicyCommand(),
+		DeletePolicyCommand(),
+	)
+
+	return cmd
+}
+
+const (
+	triggerManual     = "manual"
+	triggerScheduled  = "scheduled"
+	triggerEventBased = "event_based"
+)
+
+type policyOptions struct {
+	name              string
+	description       string
+	mode              string
+	registry          string
+	destNamespace     string
+	flatten           int8
+	repository        string
+	tag               string
+	excludeTag        string
+	labels            []string
+	excludeLabels     []string
+	resourceType      string
+	trigger           string
+	cron              string
+	override          bool
+	replicateDeletion bool
+	disable           bool
+	speed             int32
+	copyByChunk       bool
+}
+
+func addPolicyFlags(cmd *cobra.Command, opts *policyOptions) {
+	flags := cmd.Flags()
+	flags.StringVarP(&opts.name, "name", "n", "", "Name of the policy")
+	flags.StringVarP(&opts.description, "description", "d", "", "Description of the policy")
+	flags.StringVar(&opts.mode, "mode", "push", "Replication mode: push to or pull from the registry")
+	flags.StringVar(&opts.registry, "registry", "", "Name of the remote registry to push to or pull from")
+	flags.StringVar(&opts.destNamespace, "dest-namespace", "", "Namespace to replicate the resources into, defaults to the source namespace")
+	flags.Int8Var(&opts.flatten, "flatten", -1, "Number of leading path components replaced by the destination namespace, -1 for all")
+	flags.StringVar(&opts.repository, "repository", "", "Resource name pattern such as 'library/**'")
+	flags.StringVar(&opts.tag, "tag", "", "Only replicate tags matching the pattern")
+	flags.StringVar(&opts.excludeTag, "exclude-tag", "", "Do not replicate tags matching the pattern")
+	flags.StringSliceVar(&opts.labels, "label", nil, "Only replicate artifacts with all the labels")
+	flags.StringSliceVar(&opts.excludeLabels, "exclude-label", nil, "Do not replicate artifacts with the labels")
+	flags.StringVar(&opts.resourceType, "resource-type", "", "Resource type to replicate: image or artifact")
+	flags.StringVar(&opts.trigger, "trigger", "", "When to replicate: manual, scheduled or event_based, scheduled when --cron is given")
+	flags.StringVar(&opts.cron, "cron", "", "Cron schedule with seconds such as '0 0 2 * * *' for scheduled replication")
+	flags.BoolVar(&opts.override, "override", true, "Override resources that already exist on the destination")
+	flags.BoolVar(&opts.replicateDeletion, "replicate-deletion", false, "Replicate deletions of resources, event based push only")
+	flags.BoolVar(&opts.disable, "disable", false, "Disable the policy")
+	flags.Int32Var(&opts.speed, "speed", -1, "Bandwidth limit of each task in KB/s, -1 for unlimited")
+	flags.BoolVar(&opts.copyByChunk, "copy-by-chunk", false, "Copy blobs in chunks")
+}
+
+// setRegistries points the policy at the local Harbor and the remote
+// registry according to the mode.
+func setRegistries(policy *models.ReplicationPolicy, mode string, remote *models.Registry) error {
+	local := &models.Registry{ID: 0}
+	switch mode {
+	case "push":
+		policy.SrcRegistry, policy.DestRegistry = local, remote
+	case "pull":
+		policy.SrcRegistry, policy.DestRegistry = remote, local
+	default:
+		return fmt.Errorf("invalid mode %q, must be push or pull", mode)
+	}
+	return nil
+}
+
+func resolveRegistry(name string) (*models.Registry, error) {
+	if name == "" {
+		return nil, fmt.Errorf("a --registry is required")
+	}
+	id, err := api.GetRegistryIdByName(name)
+	if err != nil {
+		return nil, err
+	}
+	return &models.Registry{ID: id, Name: name}, nil
+}
+
+// buildTrigger returns the trigger for the --trigger and --cron flags.
+func buildTrigger(trigger, cron string) (*models.ReplicationTrigger, error) {
+	trigger = strings.ReplaceAll(strings.ToLower(trigger), "-", "_")
+	if trigger == "" {
+		trigger = triggerManual
+		if cron != "" {
+			trigger = triggerScheduled
+		}
+	}
+
+	switch trigger {
+	case triggerManual:
+	case triggerEventBased, "event":
+		trigger = triggerEventBased
+	case triggerScheduled:
+		if cron == "" {
+			return nil, fmt.Errorf("a --cron is required for scheduled replication")
+		}
+		return &models.ReplicationTrigger{
+			Type:            triggerScheduled,
+			TriggerSettings: &models.ReplicationTriggerSettings{Cron: cron},
+		}, nil
+	default:
+		return nil, fmt.Errorf("invalid trigger %q, must be manual, scheduled or event_based", trigger)
+	}
+	if cron != "" {
+		return nil, fmt.Errorf("--cron can only be used with scheduled replication")
+	}
+	return &models.ReplicationTrigger{Type: trigger}, nil
+}
+
+func validatePolicy(policy *models.ReplicationPolicy) error {
+	pull := policy.SrcRegistry != nil && policy.SrcRegistry.ID != 0
+	eventBased := policy.Trigger != nil && policy.Trigger.Type == triggerEventBased
+	if pull && eventBased {
+		return fmt.Errorf("event based replication is only supported in push mode")
+	}
+	if policy.ReplicateDeletion && (pull || !eventBased) {
+		return fmt.Errorf("--replicate-deletion is only supported for event based push replication")
+	}
+	return nil
+}
+
+// applyFilters updates the filters for the filter flags that were changed;
+// an empty value removes the filter of that type.
+func applyFilters(filters []*models.ReplicationFilter, opts policyOptions, changed func(name string) bool) ([]*models.ReplicationFilter, error) {
+	if changed("repository") {
+		filters = setFilter(filters, "name", opts.repository, "")
+	}
+
+	if changed("tag") || changed("exclude-tag") {
+		if opts.tag != "" && opts.excludeTag != "" {
+			return nil, fmt.Errorf("--tag and --exclude-tag cannot be used together")
+		}
+		if opts.excludeTag != "" {
+			filters = setFilter(filters, "tag", opts.excludeTag, "excludes")
+		} else {
+			filters = setFilter(filters, "tag", opts.tag, "matches")
+		}
+	}
+
+	if changed("label") || changed("exclude-label") {
+		if len(opts.labels) > 0 && len(opts.excludeLabels) > 0 {
+			return nil, fmt.Errorf("--label and --exclude-label cannot be used together")
+		}
+		if len(opts.excludeLabels) > 0 {
+			filters = setFilter(filters, "label", opts.excludeLabels, "excludes")
+		} else {
+			filters = setFilter(filters, "label", opts.labels, "matches")
+		}
+	}
+
+	if changed("resource-type") {
+		if opts.resourceType != "" && opts.resourceType != "image" && opts.resourceType != "artifact" {
+			return nil, fmt.Errorf("invalid resource type %q, must be image or artifact", opts.resourceType)
+		}
+		filters = setFilter(filters, "resource", opts.resourceType, "")
+	}
+
+	return filters, nil
+}
+
+// setFilter replaces the filter of the given type, removing it when value
+// is empty.
+func setFilter(filters []*models.ReplicationFilter, filterType string, value interface{}, decoration string) []*models.ReplicationFilter {
+	var result []*models.ReplicationFilter
+	for _, f := range filters {
+		if f.Type != filterType {
+			result = append(result, f)
+		}
+	}
+
+	empty := false
+	switch v := value.(type) {
+	case string:
+		empty = v == ""
+	case []string:
+		empty = len(v) == 0
+	}
+	if !empty {
+		result = append(result, &models.ReplicationFilter{Type: filterType, Value: value, Decoration: decoration})
+	}
+	return result
+}
+
+// findPolicy returns the replication policy with the given name or ID.
+func findPolicy(nameOrID string) (*models.ReplicationPolicy, error) {
+	policies, err := api.ListReplicationPolicies("")
+	if err != nil {
+		return nil, err
+	}
+
+	id, idErr := strconv.ParseInt(nameOrID, 10, 64)
+	for _, policy := range policies {
+		if policy.Name == nameOrID || (idErr == nil && policy.ID == id) {
+			return policy, nil
+		}
+	}
+	return nil, fmt.Errorf("replication policy %s not found", nameOrID)
+}
@@ -0,0 +1,74 @@
+package replication
+
+import (
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/api"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func CreatePolicyCommand() *cobra.Command {
+	var opts policyOptions
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "create a replication policy",
+		Long: `Create a policy that pushes resources of this Harbor to a remote registry or pulls
+them from one. The remote registry must already be added with 'harbor registry create'.
+
+Name and tag patterns use doublestar syntax: '*' matches within a path component, '**'
+across them and '{a,b}' either alternative.`,
+		Example: `  harbor replication policy create --name sync-prod --registry backup --repository 'prod/**' --tag 'v*' --trigger event_based
+  harbor replication policy create --name mirror-nginx --mode pull --registry dockerhub --repository 'library/nginx' --dest-namespace mirror --cron '0 0 2 * * *'`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			err := createPolicy(cmd, opts)
+			if err != nil {
+				log.Errorf("failed to create replication policy: %v", err)
+			}
+		},
+	}
+
+	addPolicyFlags(cmd, &opts)
+	_ = cmd.MarkFlagRequired("name")
+	_ = cmd.MarkFlagRequired("registry")
+
+	return cmd
+}
+
+func createPolicy(cmd *cobra.Command, opts policyOptions) error {
+	remote, err := resolveRegistry(opts.registry)
+	if err != nil {
+		return err
+	}
+	trigger, err := buildTrigger(opts.trigger, opts.cron)
+	if err != nil {
+		return err
+	}
+	filters, err := applyFilters(nil, opts, cmd.Flags().Changed)
+	if err != nil {
+		return err
+	}
+
+	policy := &models.ReplicationPolicy{
+		Name:                      opts.name,
+		Description:               opts.description,
+		DestNamespace:             opts.destNamespace,
+		DestNamespaceReplaceCount: &opts.flatten,
+		Filters:                   filters,
+		Trigger:                   trigger,
+		Override:                  opts.override,
+		ReplicateDeletion:         opts.replicateDeletion,
+		Enabled:                   !opts.disable,
+		Speed:                     &opts.speed,
+		CopyByChunk:               &opts.copyByChunk,
+	}
+	if err := setRegistries(policy, opts.mode, remote); err != nil {
+		return err
+	}
+	if err := validatePolicy(policy); err != nil {
+		return err
+	}
+
+	return api.CreateReplicationPolicy(policy)
+}
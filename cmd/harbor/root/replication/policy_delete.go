@@ -0,0 +1,30 @@
+package replication
+
+import (
+	"github.com/goharbor/harbor-cli/pkg/api"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func DeletePolicyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delete <policy name or ID>",
+		Short:   "delete a replication policy",
+		Example: `  harbor replication policy delete sync-prod`,
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			policy, err := findPolicy(args[0])
+			if err != nil {
+				log.Errorf("failed to delete replication policy: %v", err)
+				return
+			}
+
+			err = api.DeleteReplicationPolicy(policy.ID)
+			if err != nil {
+				log.Errorf("failed to delete replication policy: %v", err)
+			}
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,47 @@
+package replication
+
+import (
+	"github.com/goharbor/harbor-cli/pkg/api"
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	"github.com/goharbor/harbor-cli/pkg/views/replication/list"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func ListPolicyCommand() *cobra.Command {
+	var name string
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "list replication policies",
+		Example: `  harbor replication policy list --name prod`,
+		Args:    cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			var q string
+			if name != "" {
+				q = "name=~" + name
+			}
+
+			policies, err := api.ListReplicationPolicies(q)
+			if err != nil {
+				log.Errorf("failed to list replication policies: %v", err)
+				return
+			}
+
+			FormatFlag := viper.GetString("output-format")
+			if FormatFlag != "" {
+				err = utils.PrintFormat(policies, FormatFlag)
+				if err != nil {
+					log.Error(err)
+				}
+			} else {
+				list.ListPolicies(policies)
+			}
+		},
+	}
+
+	cmd.Flags().StringVarP(&name, "name", "n", "", "Only list policies whose name contains the value")
+
+	return cmd
+}
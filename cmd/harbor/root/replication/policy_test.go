@@ -0,0 +1,198 @@
+package replication
+
+import (
+	"testing"
+
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildTrigger(t *testing.T) {
+	tests := []struct {
+		name    string
+		trigger string
+		cron    string
+		want    *models.ReplicationTrigger
+		wantErr string
+	}{
+		{name: "default", want: &models.ReplicationTrigger{Type: triggerManual}},
+		{name: "manual", trigger: "Manual", want: &models.ReplicationTrigger{Type: triggerManual}},
+		{
+			name: "cron implies scheduled",
+			cron: "0 0 2 * * *",
+			want: &models.ReplicationTrigger{
+				Type:            triggerScheduled,
+				TriggerSettings: &models.ReplicationTriggerSettings{Cron: "0 0 2 * * *"},
+			},
+		},
+		{
+			name:    "scheduled",
+			trigger: "scheduled",
+			cron:    "0 30 * * * *",
+			want: &models.ReplicationTrigger{
+				Type:            triggerScheduled,
+				TriggerSettings: &models.ReplicationTriggerSettings{Cron: "0 30 * * * *"},
+			},
+		},
+		{name: "event based", trigger: "event_based", want: &models.ReplicationTrigger{Type: triggerEventBased}},
+		{name: "event-based", trigger: "event-based", want: &models.ReplicationTrigger{Type: triggerEventBased}},
+		{name: "event", trigger: "event", want: &models.ReplicationTrigger{Type: triggerEventBased}},
+		{name: "scheduled without cron", trigger: "scheduled", wantErr: "a --cron is required"},
+		{name: "manual with cron", trigger: "manual", cron: "0 0 2 * * *", wantErr: "--cron can only be used with scheduled replication"},
+		{name: "event based with cron", trigger: "event_based", cron: "0 0 2 * * *", wantErr: "--cron can only be used with scheduled replication"},
+		{name: "invalid", trigger: "hourly", wantErr: `invalid trigger "hourly"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildTrigger(tt.trigger, tt.cron)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestApplyFilters(t *testing.T) {
+	existing := []*models.ReplicationFilter{
+		{Type: "name", Value: "library/**"},
+		{Type: "tag", Value: "v*", Decoration: "matches"},
+		{Type: "label", Value: []string{"prod"}, Decoration: "matches"},
+		{Type: "resource", Value: "image"},
+	}
+
+	tests := []struct {
+		name    string
+		filters []*models.ReplicationFilter
+		opts    policyOptions
+		changed []string
+		want    []*models.ReplicationFilter
+		wantErr string
+	}{
+		{
+			name:    "new filters",
+			opts:    policyOptions{repository: "team-a/**", tag: "release-*", labels: []string{"prod", "signed"}, resourceType: "artifact"},
+			changed: []string{"repository", "tag", "label", "resource-type"},
+			want: []*models.ReplicationFilter{
+				{Type: "name", Value: "team-a/**"},
+				{Type: "tag", Value: "release-*", Decoration: "matches"},
+				{Type: "label", Value: []string{"prod", "signed"}, Decoration: "matches"},
+				{Type: "resource", Value: "artifact"},
+			},
+		},
+		{
+			name:    "unchanged flags keep the filters",
+			filters: existing,
+			opts:    policyOptions{repository: "ignored/**"},
+			want:    existing,
+		},
+		{
+			name:    "replace a filter",
+			filters: existing,
+			opts:    policyOptions{excludeTag: "*-rc*"},
+			changed: []string{"exclude-tag"},
+			want: []*models.ReplicationFilter{
+				{Type: "name", Value: "library/**"},
+				{Type: "label", Value: []string{"prod"}, Decoration: "matches"},
+				{Type: "resource", Value: "image"},
+				{Type: "tag", Value: "*-rc*", Decoration: "excludes"},
+			},
+		},
+		{
+			name:    "exclude labels",
+			filters: existing,
+			opts:    policyOptions{excludeLabels: []string{"deprecated"}},
+			changed: []string{"exclude-label"},
+			want: []*models.ReplicationFilter{
+				{Type: "name", Value: "library/**"},
+				{Type: "tag", Value: "v*", Decoration: "matches"},
+				{Type: "resource", Value: "image"},
+				{Type: "label", Value: []string{"deprecated"}, Decoration: "excludes"},
+			},
+		},
+		{
+			name:    "empty values remove filters",
+			filters: existing,
+			changed: []string{"repository", "tag", "label", "resource-type"},
+		},
+		{
+			name:    "tag and exclude tag",
+			opts:    policyOptions{tag: "v*", excludeTag: "*-rc*"},
+			changed: []string{"tag", "exclude-tag"},
+			wantErr: "--tag and --exclude-tag cannot be used together",
+		},
+		{
+			name:    "label and exclude label",
+			opts:    policyOptions{labels: []string{"prod"}, excludeLabels: []string{"deprecated"}},
+			changed: []string{"label", "exclude-label"},
+			wantErr: "--label and --exclude-label cannot be used together",
+		},
+		{
+			name:    "invalid resource type",
+			opts:    policyOptions{resourceType: "chart"},
+			changed: []string{"resource-type"},
+			wantErr: `invalid resource type "chart"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changed := func(name string) bool {
+				for _, c := range tt.changed {
+					if c == name {
+						return true
+					}
+				}
+				return false
+			}
+			got, err := applyFilters(tt.filters, tt.opts, changed)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestValidatePolicy(t *testing.T) {
+	remote := &models.Registry{ID: 3}
+	local := &models.Registry{ID: 0}
+	eventBased := &models.ReplicationTrigger{Type: triggerEventBased}
+	manual := &models.ReplicationTrigger{Type: triggerManual}
+
+	tests := []struct {
+		name    string
+		policy  *models.ReplicationPolicy
+		wantErr string
+	}{
+		{name: "event based push", policy: &models.ReplicationPolicy{SrcRegistry: local, DestRegistry: remote, Trigger: eventBased, ReplicateDeletion: true}},
+		{name: "manual pull", policy: &models.ReplicationPolicy{SrcRegistry: remote, DestRegistry: local, Trigger: manual}},
+		{
+			name:    "event based pull",
+			policy:  &models.ReplicationPolicy{SrcRegistry: remote, DestRegistry: local, Trigger: eventBased},
+			wantErr: "event based replication is only supported in push mode",
+		},
+		{
+			name:    "deletion without events",
+			policy:  &models.ReplicationPolicy{SrcRegistry: local, DestRegistry: remote, Trigger: manual, ReplicateDeletion: true},
+			wantErr: "--replicate-deletion is only supported",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validatePolicy(tt.policy)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
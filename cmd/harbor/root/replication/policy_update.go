@@ -0,0 +1,114 @@
+package replication
+
+import (
+	"github.com/goharbor/harbor-cli/pkg/api"
+	"github.com/goharbor/harbor-cli/pkg/views/replication/list"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func UpdatePolicyCommand() *cobra.Command {
+	var opts policyOptions
+	var enable bool
+
+	cmd := &cobra.Command{
+		Use:   "update <policy name or ID>",
+		Short: "update a replication policy",
+		Long: `Update a replication policy. Only the given flags are changed; a filter flag set to an
+empty value removes that filter.`,
+		Example: `  harbor replication policy update sync-prod --tag 'release-*'
+  harbor replication policy update sync-prod --cron '0 30 1 * * *'
+  harbor replication policy update sync-prod --exclude-label ''`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			err := updatePolicy(cmd, args[0], opts, enable)
+			if err != nil {
+				log.Errorf("failed to update replication policy: %v", err)
+			}
+		},
+	}
+
+	addPolicyFlags(cmd, &opts)
+	cmd.Flags().BoolVar(&enable, "enable", false, "Enable the policy")
+	cmd.MarkFlagsMutuallyExclusive("disable", "enable")
+
+	return cmd
+}
+
+func updatePolicy(cmd *cobra.Command, nameOrID string, opts policyOptions, enable bool) error {
+	policy, err := findPolicy(nameOrID)
+	if err != nil {
+		return err
+	}
+
+	flags := cmd.Flags()
+	if flags.Changed("name") {
+		policy.Name = opts.name
+	}
+	if flags.Changed("description") {
+		policy.Description = opts.description
+	}
+	if flags.Changed("mode") || flags.Changed("registry") {
+		mode := list.Mode(policy)
+		if flags.Changed("mode") {
+			mode = opts.mode
+		}
+		remote := policy.DestRegistry
+		if list.Mode(policy) == "pull" {
+			remote = policy.SrcRegistry
+		}
+		if flags.Changed("registry") {
+			remote, err = resolveRegistry(opts.registry)
+			if err != nil {
+				return err
+			}
+		}
+		if err := setRegistries(policy, mode, remote); err != nil {
+			return err
+		}
+	}
+	if flags.Changed("dest-namespace") {
+		policy.DestNamespace = opts.destNamespace
+	}
+	if flags.Changed("flatten") {
+		policy.DestNamespaceReplaceCount = &opts.flatten
+	}
+	policy.Filters, err = applyFilters(policy.Filters, opts, flags.Changed)
+	if err != nil {
+		return err
+	}
+	if flags.Changed("trigger") || flags.Changed("cron") {
+		cron := opts.cron
+		if !flags.Changed("cron") && opts.trigger == triggerScheduled && policy.Trigger != nil && policy.Trigger.TriggerSettings != nil {
+			// Switching back to a schedule keeps the previous cron.
+			cron = policy.Trigger.TriggerSettings.Cron
+		}
+		policy.Trigger, err = buildTrigger(opts.trigger, cron)
+		if err != nil {
+			return err
+		}
+	}
+	if flags.Changed("override") {
+		policy.Override = opts.override
+	}
+	if flags.Changed("replicate-deletion") {
+		policy.ReplicateDeletion = opts.replicateDeletion
+	}
+	if flags.Changed("disable") {
+		policy.Enabled = !opts.disable
+	}
+	if flags.Changed("enable") {
+		policy.Enabled = enable
+	}
+	if flags.Changed("speed") {
+		policy.Speed = &opts.speed
+	}
+	if flags.Changed("copy-by-chunk") {
+		policy.CopyByChunk = &opts.copyByChunk
+	}
+	if err := validatePolicy(policy); err != nil {
+		return err
+	}
+
+	return api.UpdateReplicationPolicy(policy)
+}
@@ -0,0 +1,37 @@
+package replication
+
+import (
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	"github.com/goharbor/harbor-cli/pkg/views/replication/view"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func ViewPolicyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "view <policy name or ID>",
+		Short:   "view a replication policy",
+		Example: `  harbor replication policy view sync-prod`,
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			policy, err := findPolicy(args[0])
+			if err != nil {
+				log.Errorf("failed to view replication policy: %v", err)
+				return
+			}
+
+			FormatFlag := viper.GetString("output-format")
+			if FormatFlag != "" {
+				err = utils.PrintFormat(policy, FormatFlag)
+				if err != nil {
+					log.Error(err)
+				}
+			} else {
+				view.ViewPolicy(policy)
+			}
+		},
+	}
+
+	return cmd
+}
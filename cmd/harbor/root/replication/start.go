@@ -0,0 +1,45 @@
+package replication
+
+import (
+	"time"
+
+	"github.com/goharbor/harbor-cli/pkg/api"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func StartCommand() *cobra.Command {
+	var watch bool
+	var interval time.Duration
+
+	cmd := &cobra.Command{
+		Use:   "start <policy name or ID>",
+		Short: "start a replication policy",
+		Long:  `Start an execution of a replication policy. With --watch its progress is shown until it finishes.`,
+		Example: `  harbor replication start sync-prod
+  harbor replication start sync-prod --watch`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			policy, err := findPolicy(args[0])
+			if err != nil {
+				log.Errorf("failed to start replication: %v", err)
+				return
+			}
+
+			executionID, err := api.StartReplication(policy.ID)
+			if err != nil {
+				log.Errorf("failed to start replication: %v", err)
+				return
+			}
+			log.Infof("Started execution %d of replication policy %s", executionID, policy.Name)
+
+			if watch {
+				watchExecution(executionID, interval)
+			}
+		},
+	}
+
+	addWatchFlags(cmd, &watch, &interval)
+
+	return cmd
+}
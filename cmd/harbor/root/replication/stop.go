@@ -0,0 +1,42 @@
+package replication
+
+import (
+	"github.com/goharbor/harbor-cli/pkg/api"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func StopCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "stop <policy name or ID>",
+		Short:   "stop the running executions of a replication policy",
+		Example: `  harbor replication stop sync-prod`,
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			policy, err := findPolicy(args[0])
+			if err != nil {
+				log.Errorf("failed to stop replication: %v", err)
+				return
+			}
+
+			running, err := api.ListReplicationExecutions(policy.ID, "InProgress")
+			if err != nil {
+				log.Errorf("failed to stop replication: %v", err)
+				return
+			}
+			if len(running) == 0 {
+				log.Infof("Replication policy %s has no running executions", policy.Name)
+				return
+			}
+
+			for _, execution := range running {
+				err = api.StopReplication(execution.ID)
+				if err != nil {
+					log.Errorf("failed to stop replication: %v", err)
+				}
+			}
+		},
+	}
+
+	return cmd
+}
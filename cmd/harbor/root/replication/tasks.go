@@ -0,0 +1,103 @@
+package replication
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/goharbor/harbor-cli/pkg/api"
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	"github.com/goharbor/harbor-cli/pkg/views/replication/executions"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func TasksCommand() *cobra.Command {
+	var status string
+	var taskID int64
+	var failedLogs, watch bool
+	var interval time.Duration
+
+	cmd := &cobra.Command{
+		Use:   "tasks <execution ID>",
+		Short: "list the tasks of a replication execution",
+		Long: `List the tasks of a replication execution, one per replicated resource. With --log the
+log of one task is printed, with --failed-logs the logs of all failed tasks.`,
+		Example: `  harbor replication tasks 42
+  harbor replication tasks 42 --watch
+  harbor replication tasks 42 --status Failed
+  harbor replication tasks 42 --log 1337`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			executionID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				log.Errorf("invalid execution ID: %s", args[0])
+				return
+			}
+
+			switch {
+			case watch:
+				watchExecution(executionID, interval)
+			case taskID != 0:
+				content, err := api.GetReplicationTaskLog(executionID, taskID)
+				if err != nil {
+					log.Errorf("failed to get replication task log: %v", err)
+					return
+				}
+				fmt.Print(content)
+			case failedLogs:
+				err = printFailedLogs(executionID)
+				if err != nil {
+					log.Errorf("failed to get replication task logs: %v", err)
+				}
+			default:
+				tasks, err := api.ListReplicationTasks(executionID, status)
+				if err != nil {
+					log.Errorf("failed to list replication tasks: %v", err)
+					return
+				}
+
+				FormatFlag := viper.GetString("output-format")
+				if FormatFlag != "" {
+					err = utils.PrintFormat(tasks, FormatFlag)
+					if err != nil {
+						log.Error(err)
+					}
+				} else {
+					executions.ListTasks(tasks)
+				}
+			}
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&status, "status", "", "Only list tasks with the status: Pending, InProgress, Succeed, Failed or Stopped")
+	flags.Int64Var(&taskID, "log", 0, "Print the log of the task with this ID")
+	flags.BoolVar(&failedLogs, "failed-logs", false, "Print the logs of all failed tasks")
+	addWatchFlags(cmd, &watch, &interval)
+	cmd.MarkFlagsMutuallyExclusive("log", "failed-logs", "watch")
+
+	return cmd
+}
+
+func printFailedLogs(executionID int64) error {
+	tasks, err := api.ListReplicationTasks(executionID, "Failed")
+	if err != nil {
+		return err
+	}
+	if len(tasks) == 0 {
+		log.Infof("Execution %d has no failed tasks", executionID)
+		return nil
+	}
+
+	for _, task := range tasks {
+		content, err := api.GetReplicationTaskLog(executionID, task.ID)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("==> task %d: %s -> %s\n", task.ID, task.SrcResource, task.DstResource)
+		fmt.Println(content)
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package retention
+
+import (
+	"fmt"
+
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/api"
+	"github.com/spf13/cobra"
+)
+
+func Retention() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retention",
+		Short: "Manage tag retention policies of projects",
+		Long: `Manage the tag retention policy of a project. Artifacts that match none of the
+rules of the policy are deleted when it runs.`,
+		Example: `  harbor retention set library --keep-pushed 10 --tags 'release-*'
+  harbor retention run library --dry-run
+  harbor retention executions library`,
+	}
+	cmd.AddCommand(
+		GetRetentionCommand(),
+		SetRetentionCommand(),
+		DeleteRetentionCommand(),
+		RunRetentionCommand(),
+		ExecutionsRetentionCommand(),
+	)
+
+	return cmd
+}
+
+// getPolicy returns the ID and retention policy of a project, failing when
+// the project has none.
+func getPolicy(projectName string) (int64, *models.RetentionPolicy, error) {
+	_, retentionID, err := api.GetProjectRetentionID(projectName)
+	if err != nil {
+		return 0, nil, err
+	}
+	if retentionID == 0 {
+		return 0, nil, fmt.Errorf("project %s has no retention policy", projectName)
+	}
+
+	policy, err := api.GetRetention(retentionID)
+	if err != nil {
+		return 0, nil, err
+	}
+	return retentionID, policy, nil
+}
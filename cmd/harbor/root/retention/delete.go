@@ -0,0 +1,80 @@
+package retention
+
+import (
+	"fmt"
+
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/api"
+	"github.com/goharbor/harbor-cli/pkg/views"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func DeleteRetentionCommand() *cobra.Command {
+	var ruleNumber int
+	var yes bool
+
+	cmd := &cobra.Command{
+		Use:   "delete <project name>",
+		Short: "delete retention rules of a project",
+		Long: `Delete one rule, numbered as in 'harbor retention get', or all rules and the schedule
+of the retention policy of a project. Without rules the policy retains everything, so deleting
+all rules asks for confirmation unless --yes is given.`,
+		Example: `  harbor retention delete library --rule 2
+  harbor retention delete library --yes`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			err := deleteRetention(args[0], cmd.Flags().Changed("rule"), ruleNumber, yes)
+			if err != nil {
+				log.Errorf("failed to delete retention rules: %v", err)
+			}
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.IntVar(&ruleNumber, "rule", 0, "Number of the rule to delete, all rules when not given")
+	flags.BoolVarP(&yes, "yes", "y", false, "Delete all rules without asking for confirmation")
+
+	return cmd
+}
+
+func deleteRetention(projectName string, oneRule bool, ruleNumber int, yes bool) error {
+	if oneRule && ruleNumber < 1 {
+		return fmt.Errorf("invalid rule %d, rules are numbered from 1", ruleNumber)
+	}
+
+	retentionID, policy, err := getPolicy(projectName)
+	if err != nil {
+		return err
+	}
+
+	if oneRule {
+		policy.Rules, err = deleteRule(policy.Rules, ruleNumber)
+		if err != nil {
+			return err
+		}
+	} else {
+		if !yes {
+			confirm, err := views.ConfirmDeletion(fmt.Sprintf("Delete all %d retention rules and the schedule of project %s?", len(policy.Rules), projectName))
+			if err != nil {
+				return err
+			}
+			if !confirm {
+				return fmt.Errorf("deletion cancelled")
+			}
+		}
+		policy.Rules = nil
+		policy.Trigger = scheduleTrigger("")
+	}
+
+	return api.UpdateRetention(retentionID, policy)
+}
+
+// deleteRule removes the rule with the given number, counted from 1.
+func deleteRule(rules []*models.RetentionRule, number int) ([]*models.RetentionRule, error) {
+	if number < 1 || number > len(rules) {
+		return nil, fmt.Errorf("invalid rule %d, the policy has %d rules", number, len(rules))
+	}
+	result := append([]*models.RetentionRule{}, rules[:number-1]...)
+	return append(result, rules[number:]...), nil
+}
@@ -0,0 +1,48 @@
+package retention
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteRule(t *testing.T) {
+	rules := []*models.RetentionRule{{Template: "always"}, {Template: "latestPushedK"}, {Template: "nDaysSinceLastPull"}}
+
+	tests := []struct {
+		number  int
+		want    []*models.RetentionRule
+		wantErr bool
+	}{
+		{number: 1, want: rules[1:]},
+		{number: 2, want: []*models.RetentionRule{rules[0], rules[2]}},
+		{number: 3, want: rules[:2]},
+		{number: 4, wantErr: true},
+		{number: 0, wantErr: true},
+		{number: -1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.number), func(t *testing.T) {
+			got, err := deleteRule(rules, tt.number)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Len(t, rules, 3, "the policy rules are not modified")
+		})
+	}
+}
+
+func TestDeleteRetentionRejectsInvalidRule(t *testing.T) {
+	// Checked before the policy is fetched, so no server is needed.
+	for _, number := range []int{0, -1} {
+		err := deleteRetention("library", true, number, false)
+		assert.ErrorContains(t, err, "rules are numbered from 1")
+	}
+}
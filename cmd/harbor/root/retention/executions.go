@@ -0,0 +1,80 @@
+package retention
+
+import (
+	"strconv"
+
+	"github.com/goharbor/harbor-cli/pkg/api"
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	"github.com/goharbor/harbor-cli/pkg/views/retention/executions"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func ExecutionsRetentionCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "executions <project name> [execution ID]",
+		Short: "list retention executions of a project or the tasks of one",
+		Long: `List the executions of the retention policy of a project. Given an execution ID, list
+its tasks with the number of artifacts retained and deleted per repository.`,
+		Example: `  harbor retention executions library
+  harbor retention executions library 42`,
+		Args: cobra.RangeArgs(1, 2),
+		Run: func(cmd *cobra.Command, args []string) {
+			retentionID, _, err := getPolicy(args[0])
+			if err != nil {
+				log.Errorf("failed to list retention executions: %v", err)
+				return
+			}
+
+			if len(args) == 2 {
+				executionID, err := strconv.ParseInt(args[1], 10, 64)
+				if err != nil {
+					log.Errorf("invalid execution ID: %s", args[1])
+					return
+				}
+				listTasks(retentionID, executionID)
+			} else {
+				listExecutions(retentionID)
+			}
+		},
+	}
+
+	return cmd
+}
+
+func listExecutions(retentionID int64) {
+	list, err := api.ListRetentionExecutions(retentionID)
+	if err != nil {
+		log.Errorf("failed to list retention executions: %v", err)
+		return
+	}
+
+	FormatFlag := viper.GetString("output-format")
+	if FormatFlag != "" {
+		err = utils.PrintFormat(list, FormatFlag)
+		if err != nil {
+			log.Error(err)
+		}
+	} else {
+		executions.ListExecutions(list)
+	}
+}
+
+func listTasks(retentionID, executionID int64) {
+	tasks, err := api.ListRetentionTasks(retentionID, executionID)
+	if err != nil {
+		log.Errorf("failed to list retention tasks: %v", err)
+		return
+	}
+
+	FormatFlag := viper.GetString("output-format")
+	if FormatFlag != "" {
+		err = utils.PrintFormat(tasks, FormatFlag)
+		if err != nil {
+			log.Error(err)
+		}
+	} else {
+		executions.ListTasks(tasks)
+	}
+}
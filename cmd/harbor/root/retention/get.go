@@ -0,0 +1,37 @@
+package retention
+
+import (
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	"github.com/goharbor/harbor-cli/pkg/views/retention/view"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func GetRetentionCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "get <project name>",
+		Short:   "show the retention rules of a project",
+		Example: `harbor retention get library`,
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			_, policy, err := getPolicy(args[0])
+			if err != nil {
+				log.Errorf("failed to get retention policy: %v", err)
+				return
+			}
+
+			FormatFlag := viper.GetString("output-format")
+			if FormatFlag != "" {
+				err = utils.PrintFormat(policy, FormatFlag)
+				if err != nil {
+					log.Error(err)
+				}
+			} else {
+				view.ViewRetention(policy)
+			}
+		},
+	}
+
+	return cmd
+}
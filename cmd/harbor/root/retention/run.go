@@ -0,0 +1,36 @@
+package retention
+
+import (
+	"github.com/goharbor/harbor-cli/pkg/api"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func RunRetentionCommand() *cobra.Command {
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "run <project name>",
+		Short: "run the retention policy of a project",
+		Long: `Run the retention policy of a project now. With --dry-run nothing is deleted; check
+'harbor retention executions' for what would have been.`,
+		Example: `harbor retention run library --dry-run`,
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			retentionID, _, err := getPolicy(args[0])
+			if err != nil {
+				log.Errorf("failed to run retention policy: %v", err)
+				return
+			}
+
+			err = api.TriggerRetention(retentionID, dryRun)
+			if err != nil {
+				log.Errorf("failed to run retention policy: %v", err)
+			}
+		},
+	}
+
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Only report the artifacts that would be deleted")
+
+	return cmd
+}
@@ -0,0 +1,169 @@
+package retention
+
+import (
+	"fmt"
+
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/api"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+// maxRules is the number of rules Harbor accepts in a retention policy.
+const maxRules = 15
+
+type ruleOptions struct {
+	keepPushed   int
+	keepPulled   int
+	pushedWithin int
+	pulledWithin int
+	always       bool
+	repos        string
+	excludeRepos string
+	tags         string
+	excludeTags  string
+	untagged     bool
+	disabled     bool
+	appendRule   bool
+	schedule     string
+}
+
+func SetRetentionCommand() *cobra.Command {
+	var opts ruleOptions
+
+	cmd := &cobra.Command{
+		Use:   "set <project name>",
+		Short: "set the retention rule of a project",
+		Long: `Set the retention rule of a project, replacing the existing rules, or add it to them
+with --append. A rule retains the artifacts of the matching repositories whose tags match and
+that satisfy one condition: --keep-pushed, --keep-pulled, --pushed-within, --pulled-within or
+--always. Patterns use doublestar syntax such as '**', 'nginx-*' or '{alpine,debian}'.`,
+		Example: `  harbor retention set library --keep-pushed 10
+  harbor retention set library --append --pulled-within 30 --repos 'team-a/**' --untagged
+  harbor retention set library --keep-pushed 5 --exclude-tags 'latest' --schedule '0 0 0 * * *'`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			err := setRetention(cmd, args[0], opts)
+			if err != nil {
+				log.Errorf("failed to set retention policy: %v", err)
+			}
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.IntVar(&opts.keepPushed, "keep-pushed", 0, "Retain the given number of most recently pushed artifacts")
+	flags.IntVar(&opts.keepPulled, "keep-pulled", 0, "Retain the given number of most recently pulled artifacts")
+	flags.IntVar(&opts.pushedWithin, "pushed-within", 0, "Retain the artifacts pushed within the given number of days")
+	flags.IntVar(&opts.pulledWithin, "pulled-within", 0, "Retain the artifacts pulled within the given number of days")
+	flags.BoolVar(&opts.always, "always", false, "Always retain the matching artifacts")
+	flags.StringVar(&opts.repos, "repos", "**", "Pattern of the repositories the rule applies to")
+	flags.StringVar(&opts.excludeRepos, "exclude-repos", "", "Pattern of the repositories the rule does not apply to")
+	flags.StringVar(&opts.tags, "tags", "**", "Pattern of the tags the rule applies to")
+	flags.StringVar(&opts.excludeTags, "exclude-tags", "", "Pattern of the tags the rule does not apply to")
+	flags.BoolVar(&opts.untagged, "untagged", false, "Apply the rule to untagged artifacts too")
+	flags.BoolVar(&opts.disabled, "disabled", false, "Add the rule disabled")
+	flags.BoolVar(&opts.appendRule, "append", false, "Add the rule to the existing rules instead of replacing them")
+	flags.StringVar(&opts.schedule, "schedule", "", "Cron schedule of the policy such as '0 0 0 * * *', empty to only run manually")
+	cmd.MarkFlagsOneRequired("keep-pushed", "keep-pulled", "pushed-within", "pulled-within", "always")
+	cmd.MarkFlagsMutuallyExclusive("keep-pushed", "keep-pulled", "pushed-within", "pulled-within", "always")
+	cmd.MarkFlagsMutuallyExclusive("repos", "exclude-repos")
+	cmd.MarkFlagsMutuallyExclusive("tags", "exclude-tags")
+
+	return cmd
+}
+
+func setRetention(cmd *cobra.Command, projectName string, opts ruleOptions) error {
+	rule, err := buildRule(opts)
+	if err != nil {
+		return err
+	}
+
+	projectID, retentionID, err := api.GetProjectRetentionID(projectName)
+	if err != nil {
+		return err
+	}
+
+	if retentionID == 0 {
+		return api.CreateRetention(&models.RetentionPolicy{
+			Algorithm: "or",
+			Rules:     []*models.RetentionRule{rule},
+			Scope:     &models.RetentionPolicyScope{Level: "project", Ref: projectID},
+			Trigger:   scheduleTrigger(opts.schedule),
+		})
+	}
+
+	policy, err := api.GetRetention(retentionID)
+	if err != nil {
+		return err
+	}
+	if opts.appendRule {
+		if len(policy.Rules) >= maxRules {
+			return fmt.Errorf("a retention policy can have at most %d rules", maxRules)
+		}
+		policy.Rules = append(policy.Rules, rule)
+	} else {
+		policy.Rules = []*models.RetentionRule{rule}
+	}
+	if cmd.Flags().Changed("schedule") || policy.Trigger == nil {
+		policy.Trigger = scheduleTrigger(opts.schedule)
+	}
+
+	return api.UpdateRetention(retentionID, policy)
+}
+
+// buildRule converts the flags into a retention rule in the format the
+// Harbor UI uses.
+func buildRule(opts ruleOptions) (*models.RetentionRule, error) {
+	var template string
+	var value int
+	switch {
+	case opts.keepPushed > 0:
+		template, value = "latestPushedK", opts.keepPushed
+	case opts.keepPulled > 0:
+		template, value = "latestPulledN", opts.keepPulled
+	case opts.pushedWithin > 0:
+		template, value = "nDaysSinceLastPush", opts.pushedWithin
+	case opts.pulledWithin > 0:
+		template, value = "nDaysSinceLastPull", opts.pulledWithin
+	case opts.always:
+		template = "always"
+	default:
+		return nil, fmt.Errorf("the number of artifacts or days to retain must be positive")
+	}
+
+	params := map[string]interface{}{}
+	if template != "always" {
+		params[template] = value
+	}
+
+	repoSelector := models.RetentionSelector{Kind: "doublestar", Decoration: "repoMatches", Pattern: opts.repos}
+	if opts.excludeRepos != "" {
+		repoSelector.Decoration, repoSelector.Pattern = "repoExcludes", opts.excludeRepos
+	}
+	tagSelector := &models.RetentionSelector{
+		Kind:       "doublestar",
+		Decoration: "matches",
+		Pattern:    opts.tags,
+		Extras:     fmt.Sprintf(`{"untagged":%t}`, opts.untagged),
+	}
+	if opts.excludeTags != "" {
+		tagSelector.Decoration, tagSelector.Pattern = "excludes", opts.excludeTags
+	}
+
+	return &models.RetentionRule{
+		Action:         "retain",
+		Disabled:       opts.disabled,
+		Template:       template,
+		Params:         params,
+		TagSelectors:   []*models.RetentionSelector{tagSelector},
+		ScopeSelectors: map[string][]models.RetentionSelector{"repository": {repoSelector}},
+	}, nil
+}
+
+func scheduleTrigger(cron string) *models.RetentionRuleTrigger {
+	return &models.RetentionRuleTrigger{
+		Kind:       "Schedule",
+		Settings:   map[string]interface{}{"cron": cron},
+		References: map[string]interface{}{},
+	}
+}
@@ -0,0 +1,127 @@
+package retention
+
+import (
+	"testing"
+
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildRule(t *testing.T) {
+	defaults := ruleOptions{repos: "**", tags: "**"}
+	with := func(apply func(*ruleOptions)) ruleOptions {
+		opts := defaults
+		apply(&opts)
+		return opts
+	}
+	allRepos := map[string][]models.RetentionSelector{
+		"repository": {{Kind: "doublestar", Decoration: "repoMatches", Pattern: "**"}},
+	}
+	allTags := []*models.RetentionSelector{
+		{Kind: "doublestar", Decoration: "matches", Pattern: "**", Extras: `{"untagged":false}`},
+	}
+
+	tests := []struct {
+		name    string
+		opts    ruleOptions
+		want    *models.RetentionRule
+		wantErr bool
+	}{
+		{
+			name: "keep pushed",
+			opts: with(func(o *ruleOptions) { o.keepPushed = 10 }),
+			want: &models.RetentionRule{
+				Action:         "retain",
+				Template:       "latestPushedK",
+				Params:         map[string]interface{}{"latestPushedK": 10},
+				TagSelectors:   allTags,
+				ScopeSelectors: allRepos,
+			},
+		},
+		{
+			name: "keep pulled",
+			opts: with(func(o *ruleOptions) { o.keepPulled = 3 }),
+			want: &models.RetentionRule{
+				Action:         "retain",
+				Template:       "latestPulledN",
+				Params:         map[string]interface{}{"latestPulledN": 3},
+				TagSelectors:   allTags,
+				ScopeSelectors: allRepos,
+			},
+		},
+		{
+			name: "pushed within",
+			opts: with(func(o *ruleOptions) { o.pushedWithin = 7 }),
+			want: &models.RetentionRule{
+				Action:         "retain",
+				Template:       "nDaysSinceLastPush",
+				Params:         map[string]interface{}{"nDaysSinceLastPush": 7},
+				TagSelectors:   allTags,
+				ScopeSelectors: allRepos,
+			},
+		},
+		{
+			name: "pulled within, matching repositories and untagged",
+			opts: with(func(o *ruleOptions) {
+				o.pulledWithin = 30
+				o.repos = "team-a/**"
+				o.untagged = true
+			}),
+			want: &models.RetentionRule{
+				Action:   "retain",
+				Template: "nDaysSinceLastPull",
+				Params:   map[string]interface{}{"nDaysSinceLastPull": 30},
+				TagSelectors: []*models.RetentionSelector{
+					{Kind: "doublestar", Decoration: "matches", Pattern: "**", Extras: `{"untagged":true}`},
+				},
+				ScopeSelectors: map[string][]models.RetentionSelector{
+					"repository": {{Kind: "doublestar", Decoration: "repoMatches", Pattern: "team-a/**"}},
+				},
+			},
+		},
+		{
+			name: "always, excluding repositories and tags, disabled",
+			opts: with(func(o *ruleOptions) {
+				o.always = true
+				o.excludeRepos = "{cache,tmp}/**"
+				o.excludeTags = "latest"
+				o.disabled = true
+			}),
+			want: &models.RetentionRule{
+				Action:   "retain",
+				Disabled: true,
+				Template: "always",
+				Params:   map[string]interface{}{},
+				TagSelectors: []*models.RetentionSelector{
+					{Kind: "doublestar", Decoration: "excludes", Pattern: "latest", Extras: `{"untagged":false}`},
+				},
+				ScopeSelectors: map[string][]models.RetentionSelector{
+					"repository": {{Kind: "doublestar", Decoration: "repoExcludes", Pattern: "{cache,tmp}/**"}},
+				},
+			},
+		},
+		{
+			name:    "no condition",
+			opts:    defaults,
+			wantErr: true,
+		},
+		{
+			name:    "negative count",
+			opts:    with(func(o *ruleOptions) { o.keepPushed = -1 }),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildRule(tt.opts)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
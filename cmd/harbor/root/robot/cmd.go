@@ -0,0 +1,23 @@
+package robot
+
+import "github.com/spf13/cobra"
+
+func Robot() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "robot",
+		Short: "Manage robot accounts",
+		Long:  `Manage project and system level robot accounts used by CI systems and other automation`,
+		Example: `  harbor robot create ci --project library --permission repository:pull --permission repository:push --secret-file ci.secret
+  harbor robot list --project library`,
+	}
+	cmd.AddCommand(
+		CreateRobotCommand(),
+		ListRobotCommand(),
+		ViewRobotCommand(),
+		UpdateRobotCommand(),
+		DeleteRobotCommand(),
+		RefreshSecretCommand(),
+	)
+
+	return cmd
+}
@@ -0,0 +1,230 @@
+package robot
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/api"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+const (
+	levelProject = "project"
+	levelSystem  = "system"
+)
+
+type robotOptions struct {
+	level             string
+	projects          []string
+	permissions       []string
+	systemPermissions []string
+	description       string
+	duration          int64
+	disable           bool
+	secretFile        string
+}
+
+func CreateRobotCommand() *cobra.Command {
+	var opts robotOptions
+
+	cmd := &cobra.Command{
+		Use:   "create <name>",
+		Short: "create a robot account",
+		Long: `Create a project or system level robot account. Permissions are given as <resource>:<action>
+pairs such as repository:pull, repository:push or artifact:delete. A project level robot gets them
+on its project; a system level robot gets them on every project given with --project ("*" for all)
+and can additionally hold system permissions such as registry:list.
+
+The secret is shown only once. Use --secret-file to write it to a file readable only by you instead
+of printing it.`,
+		Example: `  harbor robot create ci --project library --permission repository:pull,repository:push --secret-file ci.secret
+  harbor robot create scanner --level system --project '*' --permission repository:pull --system-permission scan-all:read`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			permissions, err := buildPermissions(opts.level, opts.projects, opts.permissions, opts.systemPermissions)
+			if err != nil {
+				log.Errorf("failed to create robot account: %v", err)
+				return
+			}
+			output, err := openSecretOutput(opts.secretFile)
+			if err != nil {
+				log.Errorf("failed to create robot account: %v", err)
+				return
+			}
+			defer output.close()
+
+			created, err := api.CreateRobot(&models.RobotCreate{
+				Name:        args[0],
+				Description: opts.description,
+				Level:       opts.level,
+				Duration:    opts.duration,
+				Disable:     opts.disable,
+				Permissions: permissions,
+			})
+			if err != nil {
+				log.Errorf("failed to create robot account: %v", err)
+				return
+			}
+
+			err = output.write(created.Name, created.Secret)
+			if err != nil {
+				log.Errorf("failed to output secret: %v", err)
+			}
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&opts.level, "level", "l", levelProject, "Level of the robot account: project or system")
+	flags.StringSliceVarP(&opts.projects, "project", "p", nil, "Project of a project level robot, or projects a system level robot has access to")
+	flags.StringSliceVar(&opts.permissions, "permission", nil, "Project permission as <resource>:<action>")
+	flags.StringSliceVar(&opts.systemPermissions, "system-permission", nil, "System permission as <resource>:<action>, system level only")
+	flags.StringVarP(&opts.description, "description", "d", "", "Description of the robot account")
+	flags.Int64Var(&opts.duration, "duration", 30, "Days until the robot account expires, -1 to never expire")
+	flags.BoolVar(&opts.disable, "disable", false, "Create the robot account disabled")
+	flags.StringVar(&opts.secretFile, "secret-file", "", "Write the secret to this file instead of printing it")
+
+	return cmd
+}
+
+// buildPermissions turns <resource>:<action> pairs into the permission sets
+// of a robot account of the given level.
+func buildPermissions(level string, projects, permissions, systemPermissions []string) ([]*models.RobotPermission, error) {
+	projectAccess, err := parseAccess(permissions)
+	if err != nil {
+		return nil, err
+	}
+	systemAccess, err := parseAccess(systemPermissions)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*models.RobotPermission
+	switch level {
+	case levelProject:
+		if len(projects) != 1 {
+			return nil, fmt.Errorf("a project level robot account needs exactly one --project")
+		}
+		if len(systemAccess) > 0 {
+			return nil, fmt.Errorf("system permissions require a system level robot account")
+		}
+		if len(projectAccess) == 0 {
+			return nil, fmt.Errorf("at least one --permission is required")
+		}
+		result = append(result, &models.RobotPermission{Kind: levelProject, Namespace: projects[0], Access: projectAccess})
+	case levelSystem:
+		if len(projectAccess) > 0 && len(projects) == 0 {
+			return nil, fmt.Errorf("project permissions of a system level robot account need --project")
+		}
+		if len(projectAccess) > 0 {
+			for _, project := range projects {
+				result = append(result, &models.RobotPermission{Kind: levelProject, Namespace: project, Access: projectAccess})
+			}
+		}
+		if len(systemAccess) > 0 {
+			result = append(result, &models.RobotPermission{Kind: levelSystem, Namespace: "/", Access: systemAccess})
+		}
+		if len(result) == 0 {
+			return nil, fmt.Errorf("at least one --permission or --system-permission is required")
+		}
+	default:
+		return nil, fmt.Errorf("invalid level %q, must be project or system", level)
+	}
+
+	return result, nil
+}
+
+func parseAccess(permissions []string) ([]*models.Access, error) {
+	var access []*models.Access
+	for _, permission := range permissions {
+		resource, action, found := strings.Cut(strings.TrimSpace(permission), ":")
+		if !found || resource == "" || action == "" {
+			return nil, fmt.Errorf("invalid permission %q, must be <resource>:<action>", permission)
+		}
+		access = append(access, &models.Access{Resource: resource, Action: action, Effect: "allow"})
+	}
+	return access, nil
+}
+
+// secretOutput is where the secret of a robot account goes: a file given
+// with --secret-file, or stdout.
+type secretOutput struct {
+	path    string
+	file    *os.File
+	created bool
+	written bool
+	out     io.Writer
+}
+
+// openSecretOutput opens path with owner-only permissions, or returns an
+// output printing the secret when path is empty. The file is opened before
+// the secret is generated so that an unusable path fails while the
+// previous secret is still valid.
+func openSecretOutput(path string) (*secretOutput, error) {
+	output := &secretOutput{path: path, out: os.Stdout}
+	if path == "" {
+		return output, nil
+	}
+
+	_, err := os.Stat(path)
+	output.created = os.IsNotExist(err)
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	// The mode of OpenFile only applies to new files, restrict existing
+	// ones before the secret is written to them.
+	if err := file.Chmod(0o600); err != nil {
+		file.Close()
+		return nil, err
+	}
+	output.file = file
+	return output, nil
+}
+
+// write stores the secret of a robot account. When there is no file or
+// writing to it fails, the secret is printed so that it is not lost.
+func (o *secretOutput) write(name, secret string) error {
+	if o.file == nil {
+		o.print(name, secret)
+		return nil
+	}
+
+	if err := o.writeFile(secret); err != nil {
+		o.print(name, secret)
+		return fmt.Errorf("failed to write the secret to %s, it is printed instead: %v", o.path, err)
+	}
+	fmt.Fprintf(o.out, "Name: %s\nSecret written to %s\n", name, o.path)
+	return nil
+}
+
+func (o *secretOutput) writeFile(secret string) error {
+	if err := o.file.Truncate(0); err != nil {
+		return err
+	}
+	if _, err := o.file.WriteString(secret + "\n"); err != nil {
+		return err
+	}
+	o.written = true
+	return o.file.Close()
+}
+
+func (o *secretOutput) print(name, secret string) {
+	fmt.Fprintf(o.out, "Name:   %s\nSecret: %s\n", name, secret)
+	fmt.Fprintln(o.out, "The secret cannot be shown again, store it now.")
+}
+
+// close releases the file, removing it when it was created for a secret
+// that was never written.
+func (o *secretOutput) close() {
+	if o.file == nil {
+		return
+	}
+	o.file.Close()
+	if o.created && !o.written {
+		os.Remove(o.path)
+	}
+}
@@ -0,0 +1,216 @@
+package robot
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildPermissions(t *testing.T) {
+	pull := &models.Access{Resource: "repository", Action: "pull", Effect: "allow"}
+	push := &models.Access{Resource: "repository", Action: "push", Effect: "allow"}
+	listRegistries := &models.Access{Resource: "registry", Action: "list", Effect: "allow"}
+
+	tests := []struct {
+		name              string
+		level             string
+		projects          []string
+		permissions       []string
+		systemPermissions []string
+		want              []*models.RobotPermission
+		wantErr           string
+	}{
+		{
+			name:        "project level",
+			level:       levelProject,
+			projects:    []string{"library"},
+			permissions: []string{"repository:pull", " repository:push"},
+			want: []*models.RobotPermission{
+				{Kind: levelProject, Namespace: "library", Access: []*models.Access{pull, push}},
+			},
+		},
+		{
+			name:        "project level without project",
+			level:       levelProject,
+			permissions: []string{"repository:pull"},
+			wantErr:     "needs exactly one --project",
+		},
+		{
+			name:        "project level with two projects",
+			level:       levelProject,
+			projects:    []string{"library", "team-a"},
+			permissions: []string{"repository:pull"},
+			wantErr:     "needs exactly one --project",
+		},
+		{
+			name:              "project level with system permission",
+			level:             levelProject,
+			projects:          []string{"library"},
+			permissions:       []string{"repository:pull"},
+			systemPermissions: []string{"registry:list"},
+			wantErr:           "system permissions require a system level robot account",
+		},
+		{
+			name:     "project level without permission",
+			level:    levelProject,
+			projects: []string{"library"},
+			wantErr:  "at least one --permission is required",
+		},
+		{
+			name:              "system level",
+			level:             levelSystem,
+			projects:          []string{"library", "*"},
+			permissions:       []string{"repository:pull"},
+			systemPermissions: []string{"registry:list"},
+			want: []*models.RobotPermission{
+				{Kind: levelProject, Namespace: "library", Access: []*models.Access{pull}},
+				{Kind: levelProject, Namespace: "*", Access: []*models.Access{pull}},
+				{Kind: levelSystem, Namespace: "/", Access: []*models.Access{listRegistries}},
+			},
+		},
+		{
+			name:              "system level with system permissions only",
+			level:             levelSystem,
+			systemPermissions: []string{"registry:list"},
+			want: []*models.RobotPermission{
+				{Kind: levelSystem, Namespace: "/", Access: []*models.Access{listRegistries}},
+			},
+		},
+		{
+			name:        "system level project permission without project",
+			level:       levelSystem,
+			permissions: []string{"repository:pull"},
+			wantErr:     "need --project",
+		},
+		{
+			name:     "system level without permission",
+			level:    levelSystem,
+			projects: []string{"library"},
+			wantErr:  "at least one --permission or --system-permission is required",
+		},
+		{
+			name:        "invalid permission",
+			level:       levelProject,
+			projects:    []string{"library"},
+			permissions: []string{"repository"},
+			wantErr:     `invalid permission "repository"`,
+		},
+		{
+			name:        "missing action",
+			level:       levelProject,
+			projects:    []string{"library"},
+			permissions: []string{"repository:"},
+			wantErr:     `invalid permission "repository:"`,
+		},
+		{
+			name:        "invalid level",
+			level:       "global",
+			projects:    []string{"library"},
+			permissions: []string{"repository:pull"},
+			wantErr:     `invalid level "global"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildPermissions(tt.level, tt.projects, tt.permissions, tt.systemPermissions)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSecretOutput(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing os.FileMode
+	}{
+		{name: "new file"},
+		{name: "existing readable file", existing: 0o644},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "ci.secret")
+			if tt.existing != 0 {
+				require.NoError(t, os.WriteFile(path, []byte("an old and longer secret\n"), tt.existing))
+				require.NoError(t, os.Chmod(path, tt.existing))
+			}
+
+			output, err := openSecretOutput(path)
+			require.NoError(t, err)
+			// The file is restricted before any secret is generated.
+			info, err := os.Stat(path)
+			require.NoError(t, err)
+			assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+			var printed bytes.Buffer
+			output.out = &printed
+			require.NoError(t, output.write("robot$ci", "s3cret"))
+			output.close()
+
+			content, err := os.ReadFile(path)
+			require.NoError(t, err)
+			assert.Equal(t, "s3cret\n", string(content))
+			assert.NotContains(t, printed.String(), "s3cret")
+		})
+	}
+}
+
+func TestSecretOutputFailures(t *testing.T) {
+	dir := t.TempDir()
+
+	t.Run("unusable path", func(t *testing.T) {
+		_, err := openSecretOutput(filepath.Join(dir, "missing", "ci.secret"))
+		assert.Error(t, err)
+	})
+
+	t.Run("no secret generated", func(t *testing.T) {
+		created := filepath.Join(dir, "created.secret")
+		output, err := openSecretOutput(created)
+		require.NoError(t, err)
+		output.close()
+		assert.NoFileExists(t, created, "a file created for a secret that never came is removed")
+
+		existing := filepath.Join(dir, "existing.secret")
+		require.NoError(t, os.WriteFile(existing, []byte("old\n"), 0o600))
+		output, err = openSecretOutput(existing)
+		require.NoError(t, err)
+		output.close()
+		content, err := os.ReadFile(existing)
+		require.NoError(t, err)
+		assert.Equal(t, "old\n", string(content), "the previous secret is kept")
+	})
+
+	t.Run("write fails", func(t *testing.T) {
+		output, err := openSecretOutput(filepath.Join(dir, "broken.secret"))
+		require.NoError(t, err)
+		var printed bytes.Buffer
+		output.out = &printed
+		require.NoError(t, output.file.Close())
+
+		err = output.write("robot$ci", "s3cret")
+		assert.ErrorContains(t, err, "it is printed instead")
+		assert.Contains(t, printed.String(), "Secret: s3cret")
+		output.close()
+	})
+
+	t.Run("no file", func(t *testing.T) {
+		output, err := openSecretOutput("")
+		require.NoError(t, err)
+		var printed bytes.Buffer
+		output.out = &printed
+		require.NoError(t, output.write("robot$ci", "s3cret"))
+		output.close()
+		assert.Contains(t, printed.String(), "Secret: s3cret")
+	})
+}
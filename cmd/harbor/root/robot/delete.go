@@ -0,0 +1,30 @@
+package robot
+
+import (
+	"github.com/goharbor/harbor-cli/pkg/api"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func DeleteRobotCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delete <id or name>",
+		Short:   "delete a robot account",
+		Example: `harbor robot delete 'robot$library+ci'`,
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			robotID, err := api.GetRobotIdByName(args[0])
+			if err != nil {
+				log.Errorf("failed to delete robot account: %v", err)
+				return
+			}
+
+			err = api.DeleteRobot(robotID)
+			if err != nil {
+				log.Errorf("failed to delete robot account: %v", err)
+			}
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,61 @@
+package robot
+
+import (
+	"fmt"
+
+	"github.com/goharbor/harbor-cli/pkg/api"
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	"github.com/goharbor/harbor-cli/pkg/views/robot/list"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func ListRobotCommand() *cobra.Command {
+	var projectName string
+	var system bool
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "list robot accounts",
+		Example: `  harbor robot list --project library
+  harbor robot list --system`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			var q string
+			switch {
+			case projectName != "":
+				project, err := api.GetProject(projectName)
+				if err != nil {
+					log.Errorf("failed to list robot accounts: %v", err)
+					return
+				}
+				q = fmt.Sprintf("Level=%s,ProjectID=%d", levelProject, project.Payload.ProjectID)
+			case system:
+				q = "Level=" + levelSystem
+			}
+
+			robots, err := api.ListRobots(q)
+			if err != nil {
+				log.Errorf("failed to list robot accounts: %v", err)
+				return
+			}
+
+			FormatFlag := viper.GetString("output-format")
+			if FormatFlag != "" {
+				err = utils.PrintFormat(robots, FormatFlag)
+				if err != nil {
+					log.Error(err)
+				}
+			} else {
+				list.ListRobots(robots)
+			}
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&projectName, "project", "p", "", "Only list the robot accounts of this project")
+	flags.BoolVar(&system, "system", false, "Only list system level robot accounts")
+
+	return cmd
+}
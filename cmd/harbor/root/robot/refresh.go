@@ -0,0 +1,54 @@
+package robot
+
+import (
+	"github.com/goharbor/harbor-cli/pkg/api"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func RefreshSecretCommand() *cobra.Command {
+	var secretFile string
+
+	cmd := &cobra.Command{
+		Use:     "refresh-secret <id or name>",
+		Short:   "generate a new secret for a robot account",
+		Long:    `Generate a new secret for a robot account, invalidating the previous one. The secret is shown only once.`,
+		Example: `harbor robot refresh-secret 'robot$library+ci' --secret-file ci.secret`,
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			robotID, err := api.GetRobotIdByName(args[0])
+			if err != nil {
+				log.Errorf("failed to refresh secret: %v", err)
+				return
+			}
+
+			robot, err := api.GetRobot(robotID)
+			if err != nil {
+				log.Errorf("failed to refresh secret: %v", err)
+				return
+			}
+
+			output, err := openSecretOutput(secretFile)
+			if err != nil {
+				log.Errorf("failed to refresh secret: %v", err)
+				return
+			}
+			defer output.close()
+
+			secret, err := api.RefreshRobotSecret(robotID)
+			if err != nil {
+				log.Errorf("failed to refresh secret: %v", err)
+				return
+			}
+
+			err = output.write(robot.Name, secret)
+			if err != nil {
+				log.Errorf("failed to output secret: %v", err)
+			}
+		},
+	}
+
+	cmd.Flags().StringVar(&secretFile, "secret-file", "", "Write the secret to this file instead of printing it")
+
+	return cmd
+}
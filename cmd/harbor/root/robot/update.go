@@ -0,0 +1,112 @@
+package robot
+
+import (
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/api"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func UpdateRobotCommand() *cobra.Command {
+	var opts robotOptions
+	var enable bool
+
+	cmd := &cobra.Command{
+		Use:   "update <id or name>",
+		Short: "update a robot account",
+		Long: `Update the description, expiry, status or permissions of a robot account. Only the given
+flags are changed; --project, --permission and --system-permission replace the existing projects
+and permissions, the others are kept.`,
+		Example: `  harbor robot update 'robot$library+ci' --disable
+  harbor robot update 'robot$library+ci' --permission repository:pull --duration 90
+  harbor robot update 'robot$scanner' --project library,team-a`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			robotID, err := api.GetRobotIdByName(args[0])
+			if err != nil {
+				log.Errorf("failed to update robot account: %v", err)
+				return
+			}
+
+			robot, err := api.GetRobot(robotID)
+			if err != nil {
+				log.Errorf("failed to update robot account: %v", err)
+				return
+			}
+
+			flags := cmd.Flags()
+			if flags.Changed("description") {
+				robot.Description = opts.description
+			}
+			if flags.Changed("duration") {
+				robot.Duration = opts.duration
+			}
+			if flags.Changed("disable") {
+				robot.Disable = opts.disable
+			}
+			if flags.Changed("enable") {
+				robot.Disable = !enable
+			}
+			if flags.Changed("permission") || flags.Changed("system-permission") || flags.Changed("project") {
+				current := currentPermissions(robot.Permissions)
+				projects, projectPermissions, systemPermissions := current.projects, current.permissions, current.systemPermissions
+				if flags.Changed("project") {
+					projects = opts.projects
+				}
+				if flags.Changed("permission") {
+					projectPermissions = opts.permissions
+				}
+				if flags.Changed("system-permission") {
+					systemPermissions = opts.systemPermissions
+				}
+				permissions, err := buildPermissions(robot.Level, projects, projectPermissions, systemPermissions)
+				if err != nil {
+					log.Errorf("failed to update robot account: %v", err)
+					return
+				}
+				robot.Permissions = permissions
+			}
+
+			err = api.UpdateRobot(robot)
+			if err != nil {
+				log.Errorf("failed to update robot account: %v", err)
+			}
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringSliceVarP(&opts.projects, "project", "p", nil, "Projects a system level robot has access to")
+	flags.StringSliceVar(&opts.permissions, "permission", nil, "Project permission as <resource>:<action>")
+	flags.StringSliceVar(&opts.systemPermissions, "system-permission", nil, "System permission as <resource>:<action>, system level only")
+	flags.StringVarP(&opts.description, "description", "d", "", "Description of the robot account")
+	flags.Int64Var(&opts.duration, "duration", 30, "Days until the robot account expires, -1 to never expire")
+	flags.BoolVar(&opts.disable, "disable", false, "Disable the robot account")
+	flags.BoolVar(&enable, "enable", false, "Enable the robot account")
+	cmd.MarkFlagsMutuallyExclusive("disable", "enable")
+
+	return cmd
+}
+
+// currentPermissions returns the projects and the <resource>:<action> pairs
+// of existing robot permissions, in the form taken by the flags.
+func currentPermissions(permissions []*models.RobotPermission) robotOptions {
+	var current robotOptions
+	seen := map[string]bool{}
+	for _, permission := range permissions {
+		switch permission.Kind {
+		case levelProject:
+			current.projects = append(current.projects, permission.Namespace)
+			for _, access := range permission.Access {
+				if pair := access.Resource + ":" + access.Action; !seen[pair] {
+					seen[pair] = true
+					current.permissions = append(current.permissions, pair)
+				}
+			}
+		case levelSystem:
+			for _, access := range permission.Access {
+				current.systemPermissions = append(current.systemPermissions, access.Resource+":"+access.Action)
+			}
+		}
+	}
+	return current
+}
@@ -0,0 +1,70 @@
+package robot
+
+import (
+	"testing"
+
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCurrentPermissions(t *testing.T) {
+	access := func(pairs ...string) []*models.Access {
+		var result []*models.Access
+		for _, pair := range pairs {
+			parsed, err := parseAccess([]string{pair})
+			require.NoError(t, err)
+			result = append(result, parsed...)
+		}
+		return result
+	}
+
+	tests := []struct {
+		name        string
+		permissions []*models.RobotPermission
+		want        robotOptions
+	}{
+		{
+			name: "project level",
+			permissions: []*models.RobotPermission{
+				{Kind: levelProject, Namespace: "library", Access: access("repository:pull", "repository:push")},
+			},
+			want: robotOptions{projects: []string{"library"}, permissions: []string{"repository:pull", "repository:push"}},
+		},
+		{
+			name: "system level",
+			permissions: []*models.RobotPermission{
+				{Kind: levelProject, Namespace: "library", Access: access("repository:pull")},
+				{Kind: levelProject, Namespace: "team-a", Access: access("repository:pull", "artifact:delete")},
+				{Kind: levelSystem, Namespace: "/", Access: access("registry:list")},
+			},
+			want: robotOptions{
+				projects:          []string{"library", "team-a"},
+				permissions:       []string{"repository:pull", "artifact:delete"},
+				systemPermissions: []string{"registry:list"},
+			},
+		},
+		{
+			name: "none",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, currentPermissions(tt.permissions))
+		})
+	}
+
+	// Changing only the projects of a system level robot keeps its access.
+	current := currentPermissions([]*models.RobotPermission{
+		{Kind: levelProject, Namespace: "library", Access: access("repository:pull")},
+		{Kind: levelSystem, Namespace: "/", Access: access("registry:list")},
+	})
+	permissions, err := buildPermissions(levelSystem, []string{"team-a", "team-b"}, current.permissions, current.systemPermissions)
+	require.NoError(t, err)
+	assert.Equal(t, []*models.RobotPermission{
+		{Kind: levelProject, Namespace: "team-a", Access: access("repository:pull")},
+		{Kind: levelProject, Namespace: "team-b", Access: access("repository:pull")},
+		{Kind: levelSystem, Namespace: "/", Access: access("registry:list")},
+	}, permissions)
+}
@@ -0,0 +1,44 @@
+package robot
+
+import (
+	"github.com/goharbor/harbor-cli/pkg/api"
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	"github.com/goharbor/harbor-cli/pkg/views/robot/view"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func ViewRobotCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "view <id or name>",
+		Short:   "get robot account information and permissions",
+		Example: `harbor robot view 'robot$library+ci'`,
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			robotID, err := api.GetRobotIdByName(args[0])
+			if err != nil {
+				log.Errorf("failed to get robot account: %v", err)
+				return
+			}
+
+			robot, err := api.GetRobot(robotID)
+			if err != nil {
+				log.Errorf("failed to get robot account: %v", err)
+				return
+			}
+
+			FormatFlag := viper.GetString("output-format")
+			if FormatFlag != "" {
+				err = utils.PrintFormat(robot, FormatFlag)
+				if err != nil {
+					log.Error(err)
+				}
+			} else {
+				view.ViewRobot(robot)
+			}
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,91 @@
+package webhook
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/api"
+	"github.com/goharbor/harbor-cli/pkg/webhook"
+	"github.com/spf13/cobra"
+)
+
+func Webhook() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "webhook",
+		Short: "Manage webhook policies of projects",
+		Long:  `Manage the webhook policies that notify HTTP or Slack endpoints of events in a project`,
+		Example: `  harbor webhook create library --name ci --address https://ci.example.com/hook --event PUSH_ARTIFACT
+  harbor webhook jobs library ci --failed`,
+	}
+	cmd.AddCommand(
+		ListWebhookCommand(),
+		CreateWebhookCommand(),
+		UpdateWebhookCommand(),
+		DeleteWebhookCommand(),
+		TestWebhookCommand(),
+		JobsWebhookCommand(),
+		ListenWebhookCommand(),
+	)
+
+	return cmd
+}
+
+type webhookOptions struct {
+	name           string
+	description    string
+	address        string
+	targetType     string
+	payloadFormat  string
+	authHeader     string
+	skipCertVerify bool
+	events         []string
+	disable        bool
+}
+
+func addWebhookFlags(cmd *cobra.Command, opts *webhookOptions) {
+	flags := cmd.Flags()
+	flags.StringVarP(&opts.name, "name", "n", "", "Name of the webhook")
+	flags.StringVarP(&opts.description, "description", "d", "", "Description of the webhook")
+	flags.StringVar(&opts.address, "address", "", "URL the events are sent to")
+	flags.StringVar(&opts.targetType, "type", webhook.TargetHTTP, "Type of the endpoint: http or slack")
+	flags.StringVar(&opts.payloadFormat, "payload-format", webhook.FormatDefault, "Payload format of http endpoints: Default or CloudEvents")
+	flags.StringVar(&opts.authHeader, "auth-header", "", "Value of the Authorization header sent with the events")
+	flags.BoolVar(&opts.skipCertVerify, "skip-cert-verify", false, "Do not verify the certificate of the endpoint")
+	flags.StringSliceVarP(&opts.events, "event", "e", nil, "Event types to send such as PUSH_ARTIFACT or SCANNING_COMPLETED")
+	flags.BoolVar(&opts.disable, "disable", false, "Disable the webhook")
+}
+
+func validateTarget(target *models.WebhookTargetObject) error {
+	switch target.Type {
+	case webhook.TargetHTTP:
+		if target.PayloadFormat != webhook.FormatDefault && target.PayloadFormat != webhook.FormatCloudEvents {
+			return fmt.Errorf("invalid payload format %q, must be Default or CloudEvents", target.PayloadFormat)
+		}
+	case webhook.TargetSlack:
+		target.PayloadFormat = ""
+	default:
+		return fmt.Errorf("invalid type %q, must be http or slack", target.Type)
+	}
+	if target.Address == "" {
+		return fmt.Errorf("an --address is required")
+	}
+	return nil
+}
+
+// findWebhook returns the webhook policy of the project with the given
+// name or ID.
+func findWebhook(projectName, nameOrID string) (*models.WebhookPolicy, error) {
+	policies, err := api.ListWebhooks(projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	id, idErr := strconv.ParseInt(nameOrID, 10, 64)
+	for _, policy := range policies {
+		if policy.Name == nameOrID || (idErr == nil && policy.ID == id) {
+			return policy, nil
+		}
+	}
+	return nil, fmt.Errorf("webhook %s not found in project %s", nameOrID, projectName)
+}
@@ -0,0 +1,72 @@
+package webhook
+
+import (
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/api"
+	"github.com/goharbor/harbor-cli/pkg/prompt"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func CreateWebhookCommand() *cobra.Command {
+	var opts webhookOptions
+
+	cmd := &cobra.Command{
+		Use:   "create [project name]",
+		Short: "create a webhook in a project",
+		Long: `Create a webhook that sends the given event types of a project to an HTTP or Slack
+endpoint. Without --event every event type the project supports is sent.`,
+		Example: `  harbor webhook create library --name ci --address https://ci.example.com/hook --event PUSH_ARTIFACT --auth-header "Bearer s3cr3t"
+  harbor webhook create library --name alerts --type slack --address https://hooks.slack.com/services/... --event SCANNING_FAILED,QUOTA_EXCEED`,
+		Args: cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			var projectName string
+			if len(args) > 0 {
+				projectName = args[0]
+			} else {
+				projectName = prompt.GetProjectNameFromUser()
+			}
+
+			err := createWebhook(projectName, opts)
+			if err != nil {
+				log.Errorf("failed to create webhook: %v", err)
+			}
+		},
+	}
+
+	addWebhookFlags(cmd, &opts)
+	_ = cmd.MarkFlagRequired("name")
+	_ = cmd.MarkFlagRequired("address")
+
+	return cmd
+}
+
+func createWebhook(projectName string, opts webhookOptions) error {
+	target := &models.WebhookTargetObject{
+		Address:        opts.address,
+		Type:           opts.targetType,
+		PayloadFormat:  models.PayloadFormatType(opts.payloadFormat),
+		AuthHeader:     opts.authHeader,
+		SkipCertVerify: opts.skipCertVerify,
+	}
+	if err := validateTarget(target); err != nil {
+		return err
+	}
+
+	events := opts.events
+	if len(events) == 0 {
+		var err error
+		events, err = api.GetWebhookEventTypes(projectName)
+		if err != nil {
+			return err
+		}
+	}
+
+	return api.CreateWebhook(projectName, &models.WebhookPolicy{
+		Name:        opts.name,
+		Description: opts.description,
+		Enabled:     !opts.disable,
+		EventTypes:  events,
+		Targets:     []*models.WebhookTargetObject{target},
+	})
+}
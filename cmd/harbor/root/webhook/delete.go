@@ -0,0 +1,30 @@
+package webhook
+
+import (
+	"github.com/goharbor/harbor-cli/pkg/api"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func DeleteWebhookCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delete <project name> <webhook name or ID>",
+		Short:   "delete a webhook",
+		Example: `harbor webhook delete library ci`,
+		Args:    cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			policy, err := findWebhook(args[0], args[1])
+			if err != nil {
+				log.Errorf("failed to delete webhook: %v", err)
+				return
+			}
+
+			err = api.DeleteWebhook(args[0], policy.ID)
+			if err != nil {
+				log.Errorf("failed to delete webhook: %v", err)
+			}
+		},
+	}
+
+	return cmd
+}
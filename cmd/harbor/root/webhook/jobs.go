@@ -0,0 +1,88 @@
+package webhook
+
+import (
+	"fmt"
+
+	"github.com/goharbor/harbor-cli/pkg/api"
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	"github.com/goharbor/harbor-cli/pkg/views/webhook/jobs"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func JobsWebhookCommand() *cobra.Command {
+	var failed bool
+	var executionID, taskID int64
+
+	cmd := &cobra.Command{
+		Use:   "jobs <project name> <webhook name or ID>",
+		Short: "show the delivery history of a webhook",
+		Long: `List the event deliveries of a webhook, latest first. Given --execution, list the delivery
+attempts of one event, and with --log also the log of one attempt to see why it failed.`,
+		Example: `  harbor webhook jobs library ci --failed
+  harbor webhook jobs library ci --execution 120
+  harbor webhook jobs library ci --execution 120 --log 131`,
+		Args: cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			projectName := args[0]
+			policy, err := findWebhook(projectName, args[1])
+			if err != nil {
+				log.Errorf("failed to get webhook jobs: %v", err)
+				return
+			}
+
+			switch {
+			case taskID != 0:
+				content, err := api.GetWebhookTaskLog(projectName, policy.ID, executionID, taskID)
+				if err != nil {
+					log.Errorf("failed to get webhook job log: %v", err)
+					return
+				}
+				fmt.Print(content)
+			case executionID != 0:
+				tasks, err := api.ListWebhookTasks(projectName, policy.ID, executionID)
+				if err != nil {
+					log.Errorf("failed to get webhook jobs: %v", err)
+					return
+				}
+				FormatFlag := viper.GetString("output-format")
+				if FormatFlag != "" {
+					err = utils.PrintFormat(tasks, FormatFlag)
+					if err != nil {
+						log.Error(err)
+					}
+				} else {
+					jobs.ListTasks(tasks)
+				}
+			default:
+				q := ""
+				if failed {
+					q = "status=Error"
+				}
+				executions, err := api.ListWebhookExecutions(projectName, policy.ID, q)
+				if err != nil {
+					log.Errorf("failed to get webhook jobs: %v", err)
+					return
+				}
+				FormatFlag := viper.GetString("output-format")
+				if FormatFlag != "" {
+					err = utils.PrintFormat(executions, FormatFlag)
+					if err != nil {
+						log.Error(err)
+					}
+				} else {
+					jobs.ListExecutions(executions)
+				}
+			}
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.BoolVar(&failed, "failed", false, "Only list failed deliveries")
+	flags.Int64Var(&executionID, "execution", 0, "List the delivery attempts of this execution")
+	flags.Int64Var(&taskID, "log", 0, "Print the log of this delivery attempt, requires --execution")
+	cmd.MarkFlagsRequiredTogether("log", "execution")
+
+	return cmd
+}
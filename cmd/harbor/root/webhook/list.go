@@ -0,0 +1,46 @@
+package webhook
+
+import (
+	"github.com/goharbor/harbor-cli/pkg/api"
+	"github.com/goharbor/harbor-cli/pkg/prompt"
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	"github.com/goharbor/harbor-cli/pkg/views/webhook/list"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func ListWebhookCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list [project name]",
+		Short:   "list webhooks of a project",
+		Example: `harbor webhook list library`,
+		Args:    cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			var projectName string
+			if len(args) > 0 {
+				projectName = args[0]
+			} else {
+				projectName = prompt.GetProjectNameFromUser()
+			}
+
+			policies, err := api.ListWebhooks(projectName)
+			if err != nil {
+				log.Errorf("failed to list webhooks: %v", err)
+				return
+			}
+
+			FormatFlag := viper.GetString("output-format")
+			if FormatFlag != "" {
+				err = utils.PrintFormat(policies, FormatFlag)
+				if err != nil {
+					log.Error(err)
+				}
+			} else {
+				list.ListWebhooks(policies)
+			}
+		},
+	}
+
+	return cmd
+}
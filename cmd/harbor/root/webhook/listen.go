@@ -0,0 +1,171 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/goharbor/harbor-cli/pkg/views/webhook/listen"
+	"github.com/goharbor/harbor-cli/pkg/webhook"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type listenOptions struct {
+	host        string
+	port        int
+	authHeader  string
+	fromWebhook string
+	jsonLines   bool
+	command     string
+}
+
+func ListenWebhookCommand() *cobra.Command {
+	var opts listenOptions
+
+	cmd := &cobra.Command{
+		Use:   "listen",
+		Short: "receive webhook events locally for debugging",
+		Long: `Run a local HTTP server that receives Harbor webhook events, in the Default or CloudEvents
+payload format, and prints them as a live table or as JSON lines. Requests without the expected
+Authorization header are rejected. With --exec every event is passed as JSON on the standard input
+of a shell command, with its type in HARBOR_EVENT_TYPE.`,
+		Example: `  harbor webhook listen --port 8080 --auth-header "Bearer s3cr3t"
+  harbor webhook listen --from-webhook library/ci --json
+  harbor webhook listen --exec 'jq .event_data.resources'`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			err := listenWebhooks(opts)
+			if err != nil {
+				log.Errorf("failed to listen for webhooks: %v", err)
+			}
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&opts.host, "host", "", "Address to listen on, all interfaces by default")
+	flags.IntVarP(&opts.port, "port", "p", 8080, "Port to listen on")
+	flags.StringVar(&opts.authHeader, "auth-header", "", "Authorization header the events must carry")
+	flags.StringVar(&opts.fromWebhook, "from-webhook", "", "Use the auth header of the webhook given as <project>/<webhook name or ID>")
+	flags.BoolVar(&opts.jsonLines, "json", false, "Print the events as JSON lines")
+	flags.StringVar(&opts.command, "exec", "", "Shell command to run for each event with the event JSON on its standard input")
+	cmd.MarkFlagsMutuallyExclusive("auth-header", "from-webhook")
+
+	return cmd
+}
+
+func listenWebhooks(opts listenOptions) error {
+	authHeader := opts.authHeader
+	if opts.fromWebhook != "" {
+		projectName, name, found := strings.Cut(opts.fromWebhook, "/")
+		if !found {
+			return fmt.Errorf("invalid webhook %q, must be <project>/<webhook name or ID>", opts.fromWebhook)
+		}
+		policy, err := findWebhook(projectName, name)
+		if err != nil {
+			return err
+		}
+		if len(policy.Targets) > 0 {
+			authHeader = policy.Targets[0].AuthHeader
+		}
+	}
+	if authHeader == "" {
+		log.Warn("no auth header configured, accepting every request")
+	}
+
+	jsonLines := opts.jsonLines || viper.GetString("output-format") == "json"
+
+	// Events are handled in order by a single worker so a slow command does
+	// not hold up the responses to Harbor.
+	events := make(chan webhook.Event, 100)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if !jsonLines {
+			listen.PrintHeader()
+		}
+		for event := range events {
+			if jsonLines {
+				listen.PrintJSON(event)
+			} else {
+				listen.PrintEvent(event)
+			}
+			if opts.command != "" {
+				if err := runEventCommand(opts.command, event); err != nil {
+					log.Errorf("command failed for %s event: %v", event.Payload.Type, err)
+				}
+			}
+		}
+	}()
+
+	listener := &webhook.Listener{
+		AuthHeader: authHeader,
+		OnEvent: func(event webhook.Event) {
+			select {
+			case events <- event:
+			default:
+				log.Warnf("dropping %s event, too many events pending", event.Payload.Type)
+			}
+		},
+		OnError: func(r *http.Request, err error) {
+			log.Warnf("rejected request from %s: %v", r.RemoteAddr, err)
+		},
+	}
+
+	server := &http.Server{
+		Addr:              net.JoinHostPort(opts.host, strconv.Itoa(opts.port)),
+		Handler:           listener,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	shutdown := make(chan struct{})
+	go func() {
+		defer close(shutdown)
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+
+	log.Infof("listening for webhook events on %s", server.Addr)
+	err := server.ListenAndServe()
+	if errors.Is(err, http.ErrServerClosed) {
+		// Shutdown returns once the running handlers are done with events.
+		<-shutdown
+		err = nil
+	}
+	close(events)
+	<-done
+	return err
+}
+
+func runEventCommand(command string, event webhook.Event) error {
+	input, err := json.Marshal(event.Payload)
+	if err != nil {
+		return err
+	}
+
+	cmd := exec.Command("sh", "-c", command) //nolint:gosec // the command is given by the user on purpose
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Env = append(os.Environ(),
+		"HARBOR_EVENT_TYPE="+event.Payload.Type,
+		"HARBOR_EVENT_OPERATOR="+event.Payload.Operator,
+	)
+	return cmd.Run()
+}
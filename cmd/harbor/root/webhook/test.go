@@ -0,0 +1,47 @@
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"github.com/goharbor/harbor-cli/pkg/webhook"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func TestWebhookCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "test <project name> <webhook name or ID>",
+		Short: "send a test event to the endpoints of a webhook",
+		Long: `Send a sample PUSH_ARTIFACT event to the endpoints of a webhook, with its auth header and
+payload format, to check they are reachable and accept it. The event is sent from this machine,
+so it does not prove the Harbor server can reach the endpoints.`,
+		Example: `harbor webhook test library ci`,
+		Args:    cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			policy, err := findWebhook(args[0], args[1])
+			if err != nil {
+				log.Errorf("failed to test webhook: %v", err)
+				return
+			}
+
+			payload := webhook.SamplePayload(args[0], time.Now())
+			for _, target := range policy.Targets {
+				status, err := webhook.Send(context.Background(), webhook.Target{
+					Address:        target.Address,
+					Type:           target.Type,
+					PayloadFormat:  string(target.PayloadFormat),
+					AuthHeader:     target.AuthHeader,
+					SkipCertVerify: target.SkipCertVerify,
+				}, payload)
+				if err != nil {
+					log.Errorf("test event to %s failed: %v", target.Address, err)
+					continue
+				}
+				log.Infof("test event delivered to %s: %d", target.Address, status)
+			}
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,82 @@
+package webhook
+
+import (
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/api"
+	"github.com/goharbor/harbor-cli/pkg/webhook"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func UpdateWebhookCommand() *cobra.Command {
+	var opts webhookOptions
+	var enable bool
+
+	cmd := &cobra.Command{
+		Use:   "update <project name> <webhook name or ID>",
+		Short: "update a webhook",
+		Long:  `Update a webhook of a project. Only the given flags are changed; --event replaces the event types.`,
+		Example: `  harbor webhook update library ci --event PUSH_ARTIFACT,DELETE_ARTIFACT
+  harbor webhook update library ci --disable`,
+		Args: cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			policy, err := findWebhook(args[0], args[1])
+			if err != nil {
+				log.Errorf("failed to update webhook: %v", err)
+				return
+			}
+
+			flags := cmd.Flags()
+			if flags.Changed("name") {
+				policy.Name = opts.name
+			}
+			if flags.Changed("description") {
+				policy.Description = opts.description
+			}
+			if flags.Changed("event") {
+				policy.EventTypes = opts.events
+			}
+			if flags.Changed("disable") {
+				policy.Enabled = !opts.disable
+			}
+			if flags.Changed("enable") {
+				policy.Enabled = enable
+			}
+
+			if len(policy.Targets) == 0 {
+				policy.Targets = []*models.WebhookTargetObject{{}}
+			}
+			target := policy.Targets[0]
+			if flags.Changed("address") {
+				target.Address = opts.address
+			}
+			if flags.Changed("type") {
+				target.Type = opts.targetType
+			}
+			if flags.Changed("payload-format") || (target.Type == webhook.TargetHTTP && target.PayloadFormat == "") {
+				target.PayloadFormat = models.PayloadFormatType(opts.payloadFormat)
+			}
+			if flags.Changed("auth-header") {
+				target.AuthHeader = opts.authHeader
+			}
+			if flags.Changed("skip-cert-verify") {
+				target.SkipCertVerify = opts.skipCertVerify
+			}
+			if err := validateTarget(target); err != nil {
+				log.Errorf("failed to update webhook: %v", err)
+				return
+			}
+
+			err = api.UpdateWebhook(args[0], policy)
+			if err != nil {
+				log.Errorf("failed to update webhook: %v", err)
+			}
+		},
+	}
+
+	addWebhookFlags(cmd, &opts)
+	cmd.Flags().BoolVar(&enable, "enable", false, "Enable the webhook")
+	cmd.MarkFlagsMutuallyExclusive("disable", "enable")
+
+	return cmd
+}
@@ -1,8 +1,14 @@
 package api
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
 
+	"github.com/go-openapi/runtime"
 	"github.com/goharbor/go-client/pkg/sdk/v2.0/client/artifact"
 	"github.com/goharbor/go-client/pkg/sdk/v2.0/client/scan"
 	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
@@ -87,12 +93,16 @@ func ListArtifact(projectName, repoName string, opts ...ListFlags) (artifact.Lis
 		listFlags = opts[0]
 	}
 	response, err := client.Artifact.ListArtifacts(ctx, &artifact.ListArtifactsParams{
-		ProjectName:    projectName,
-		RepositoryName: repoName,
-		Page:           &listFlags.Page,
-		PageSize:       &listFlags.PageSize,
-		Q:              &listFlags.Q,
-		Sort:           &listFlags.Sort,
+		ProjectName:      projectName,
+		RepositoryName:   repoName,
+		Page:             &listFlags.Page,
+		PageSize:         &listFlags.PageSize,
+		Q:                &listFlags.Q,
+		Sort:             &listFlags.Sort,
+		WithLabel:        &listFlags.WithLabel,
+		WithAccessory:    &listFlags.WithAccessory,
+		WithTag:          &listFlags.WithTag,
+		WithScanOverview: &listFlags.WithScanOverview,
 	})
 	if err != nil {
 		switch err.(type) {
@@ -112,6 +122,27 @@ func ListArtifact(projectName, repoName string, opts ...ListFlags) (artifact.Lis
 	return *response, nil
 }
 
+// ListAllArtifacts lists the artifacts of a repository across all pages.
+func ListAllArtifacts(projectName, repoName string, opts ListFlags) ([]*models.Artifact, error) {
+	var artifacts []*models.Artifact
+
+	opts.Page = 1
+	opts.PageSize = 100
+	for {
+		response, err := ListArtifact(projectName, repoName, opts)
+		if err != nil {
+			return nil, err
+		}
+		artifacts = append(artifacts, response.Payload...)
+		if int64(len(response.Payload)) < opts.PageSize {
+			break
+		}
+		opts.Page++
+	}
+
+	return artifacts, nil
+}
+
 // StartScanArtifact initiates a scan on a specific artifact.
 func StartScanArtifact(projectName, repoName, reference string) error {
 	ctx, client, err := utils.ContextWithClient()
@@ -198,7 +229,7 @@ func DeleteTag(projectName, repoName, reference, tag string) error {
 	if err != nil {
 		log.Errorf("Failed to delete tag: ")
 
-		switch err.(type) {
+		switch e := err.(type) {
 		case *artifact.DeleteTagForbidden:
 			return fmt.Errorf("Forbidden to delete tag: %s/%s@%s:%s", projectName, repoName, reference, tag)
 		case *artifact.DeleteTagInternalServerError:
@@ -207,6 +238,11 @@ func DeleteTag(projectName, repoName, reference, tag string) error {
 			return fmt.Errorf("Tag not found: %s/%s@%s:%s", projectName, repoName, reference, tag)
 		case *artifact.DeleteTagUnauthorized:
 			return fmt.Errorf("Unauthorized to delete tag: %s/%s@%s:%s", projectName, repoName, reference, tag)
+		case *runtime.APIError:
+			if e.Code == http.StatusPreconditionFailed {
+				return fmt.Errorf("%w: %s/%s@%s:%s", ErrTagImmutable, projectName, repoName, reference, tag)
+			}
+			return fmt.Errorf("Unknown error occurred while deleting tag: %v", err)
 		default:
 			return fmt.Errorf("Unknown error occurred while deleting tag: %v", err)
 		}
@@ -286,3 +322,302 @@ func CreateTag(projectName, repoName, reference, tagName string) error {
 	log.Infof("Tag created successfully: %s/%s@%s:%s", projectName, repoName, reference, tagName)
 	return nil
 }
+
+// CopyArtifact copies an artifact into the given project and repository.
+// The source is referenced as "project/repository:tag" or "project/repository@digest".
+func CopyArtifact(from, projectName, repoName string) error {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return fmt.Errorf("Failed to initialize client context")
+	}
+
+	_, err = client.Artifact.CopyArtifact(ctx, &artifact.CopyArtifactParams{
+		From:           from,
+		ProjectName:    projectName,
+		RepositoryName: repoName,
+	})
+	if err != nil {
+		switch err.(type) {
+		case *artifact.CopyArtifactBadRequest:
+			return fmt.Errorf("Bad request for copying artifact %s to %s/%s", from, projectName, repoName)
+		case *artifact.CopyArtifactForbidden:
+			return fmt.Errorf("Forbidden to copy artifact %s to %s/%s", from, projectName, repoName)
+		case *artifact.CopyArtifactInternalServerError:
+			return fmt.Errorf("Internal server error occurred while copying artifact %s to %s/%s", from, projectName, repoName)
+		case *artifact.CopyArtifactMethodNotAllowed:
+			return fmt.Errorf("Copying artifact %s to %s/%s is not allowed, the destination may be a proxy cache project", from, projectName, repoName)
+		case *artifact.CopyArtifactNotFound:
+			return fmt.Errorf("Artifact or destination project not found: %s -> %s/%s", from, projectName, repoName)
+		case *artifact.CopyArtifactUnauthorized:
+			return fmt.Errorf("Unauthorized to copy artifact %s to %s/%s", from, projectName, repoName)
+		default:
+			return fmt.Errorf("Unknown error occurred while copying artifact: %v", err)
+		}
+	}
+
+	log.Infof("Artifact %s copied successfully to %s/%s", from, projectName, repoName)
+	return nil
+}
+
+// AddLabel attaches a label to a specific artifact.
+func AddLabel(projectName, repoName, reference string, labelID int64) error {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return fmt.Errorf("Failed to initialize client context")
+	}
+
+	_, err = client.Artifact.AddLabel(ctx, &artifact.AddLabelParams{
+		ProjectName:    projectName,
+		RepositoryName: repoName,
+		Reference:      reference,
+		Label:          &models.Label{ID: labelID},
+	})
+	if err != nil {
+		switch err.(type) {
+		case *artifact.AddLabelBadRequest:
+			return fmt.Errorf("Bad request for adding label %d to artifact: %s/%s@%s", labelID, projectName, repoName, reference)
+		case *artifact.AddLabelConflict:
+			return fmt.Errorf("Label %d is already attached to artifact: %s/%s@%s", labelID, projectName, repoName, reference)
+		case *artifact.AddLabelForbidden:
+			return fmt.Errorf("Forbidden to add label to artifact: %s/%s@%s", projectName, repoName, reference)
+		case *artifact.AddLabelInternalServerError:
+			return fmt.Errorf("Internal server error occurred while adding label to artifact: %s/%s@%s", projectName, repoName, reference)
+		case *artifact.AddLabelNotFound:
+			return fmt.Errorf("Artifact or label not found: %s/%s@%s", projectName, repoName, reference)
+		case *artifact.AddLabelUnauthorized:
+			return fmt.Errorf("Unauthorized to add label to artifact: %s/%s@%s", projectName, repoName, reference)
+		default:
+			return fmt.Errorf("Unknown error occurred while adding label to artifact: %v", err)
+		}
+	}
+
+	log.Infof("Label added successfully to artifact: %s/%s@%s", projectName, repoName, reference)
+	return nil
+}
+
+// RemoveLabel detaches a label from a specific artifact.
+func RemoveLabel(projectName, repoName, reference string, labelID int64) error {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return fmt.Errorf("Failed to initialize client context")
+	}
+
+	_, err = client.Artifact.RemoveLabel(ctx, &artifact.RemoveLabelParams{
+		ProjectName:    projectName,
+		RepositoryName: repoName,
+		Reference:      reference,
+		LabelID:        labelID,
+	})
+	if err != nil {
+		switch err.(type) {
+		case *artifact.RemoveLabelConflict:
+			return fmt.Errorf("Conflict while removing label %d from artifact: %s/%s@%s", labelID, projectName, repoName, reference)
+		case *artifact.RemoveLabelForbidden:
+			return fmt.Errorf("Forbidden to remove label from artifact: %s/%s@%s", projectName, repoName, reference)
+		case *artifact.RemoveLabelInternalServerError:
+			return fmt.Errorf("Internal server error occurred while removing label from artifact: %s/%s@%s", projectName, repoName, reference)
+		case *artifact.RemoveLabelNotFound:
+			return fmt.Errorf("Label %d is not attached to artifact: %s/%s@%s", labelID, projectName, repoName, reference)
+		case *artifact.RemoveLabelUnauthorized:
+			return fmt.Errorf("Unauthorized to remove label from artifact: %s/%s@%s", projectName, repoName, reference)
+		default:
+			return fmt.Errorf("Unknown error occurred while removing label from artifact: %v", err)
+		}
+	}
+
+	log.Infof("Label removed successfully from artifact: %s/%s@%s", projectName, repoName, reference)
+	return nil
+}
+
+// ListArtifactLabels lists the labels attached to a specific artifact.
+func ListArtifactLabels(projectName, repoName, reference string) ([]*models.Label, error) {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return nil, fmt.Errorf("Failed to initialize client context")
+	}
+
+	withLabel := true
+	response, err := client.Artifact.GetArtifact(ctx, &artifact.GetArtifactParams{
+		ProjectName:    projectName,
+		RepositoryName: repoName,
+		Reference:      reference,
+		WithLabel:      &withLabel,
+	})
+	if err != nil {
+		switch err.(type) {
+		case *artifact.GetArtifactForbidden:
+			return nil, fmt.Errorf("Forbidden to retrieve artifact: %s/%s@%s", projectName, repoName, reference)
+		case *artifact.GetArtifactInternalServerError:
+			return nil, fmt.Errorf("Internal server error occurred while retrieving artifact: %s/%s@%s", projectName, repoName, reference)
+		case *artifact.GetArtifactNotFound:
+			return nil, fmt.Errorf("Artifact not found: %s/%s@%s", projectName, repoName, reference)
+		case *artifact.GetArtifactUnauthorized:
+			return nil, fmt.Errorf("Unauthorized to retrieve artifact: %s/%s@%s", projectName, repoName, reference)
+		default:
+			return nil, fmt.Errorf("Unknown error occurred while retrieving artifact labels: %v", err)
+		}
+	}
+
+	return response.Payload.Labels, nil
+}
+
+// ListAccessories lists the accessories such as signatures and SBOMs attached to an artifact.
+func ListAccessories(projectName, repoName, reference string) ([]*models.Accessory, error) {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return nil, fmt.Errorf("Failed to initialize client context")
+	}
+
+	var accessories []*models.Accessory
+	page, pageSize := int64(1), int64(100)
+	for {
+		response, err := client.Artifact.ListAccessories(ctx, &artifact.ListAccessoriesParams{
+			ProjectName:    projectName,
+			RepositoryName: repoName,
+			Reference:      reference,
+			Page:           &page,
+			PageSize:       &pageSize,
+		})
+		if err != nil {
+			switch err.(type) {
+			case *artifact.ListAccessoriesBadRequest:
+				return nil, fmt.Errorf("Bad request for listing accessories: %s/%s@%s", projectName, repoName, reference)
+			case *artifact.ListAccessoriesForbidden:
+				return nil, fmt.Errorf("Forbidden to list accessories: %s/%s@%s", projectName, repoName, reference)
+			case *artifact.ListAccessoriesInternalServerError:
+				return nil, fmt.Errorf("Internal server error occurred while listing accessories: %s/%s@%s", projectName, repoName, reference)
+			case *artifact.ListAccessoriesNotFound:
+				return nil, fmt.Errorf("Artifact not found: %s/%s@%s", projectName, repoName, reference)
+			case *artifact.ListAccessoriesUnauthorized:
+				return nil, fmt.Errorf("Unauthorized to list accessories: %s/%s@%s", projectName, repoName, reference)
+			default:
+				return nil, fmt.Errorf("Unknown error occurred while listing accessories: %v", err)
+			}
+		}
+		accessories = append(accessories, response.Payload...)
+		if int64(len(response.Payload)) < pageSize {
+			break
+		}
+		page++
+	}
+
+	return accessories, nil
+}
+
+// GetAddition retrieves an addition of an artifact such as its build history
+// or README. The content is returned as sent by Harbor: JSON for the build
+// history and dependencies, markdown for the README and YAML for the values.
+func GetAddition(projectName, repoName, reference, addition string) ([]byte, error) {
+	credential, err := utils.GetCurrentCredential()
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get the current credential: %v", err)
+	}
+	return getAddition(http.DefaultClient, credential, projectName, repoName, reference, addition)
+}
+
+// getAddition fetches an addition with a plain request since the generated
+// client decodes every addition into a string, which fails for JSON arrays,
+// objects and markdown.
+func getAddition(httpClient *http.Client, credential utils.Credential, projectName, repoName, reference, addition string) ([]byte, error) {
+	// Harbor expects repository names containing slashes to be encoded twice.
+	endpoint := fmt.Sprintf("%s/api/v2.0/projects/%s/repositories/%s/artifacts/%s/additions/%s",
+		strings.TrimSuffix(utils.FormatUrl(credential.ServerAddress), "/"),
+		url.PathEscape(projectName),
+		url.PathEscape(url.PathEscape(repoName)),
+		url.PathEscape(reference),
+		url.PathEscape(addition),
+	)
+	req, err := http.NewRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(credential.Username, credential.Password)
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get %s of artifact: %s/%s@%s: %v", addition, projectName, repoName, reference, err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read %s of artifact: %s/%s@%s: %v", addition, projectName, repoName, reference, err)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return body, nil
+	case http.StatusBadRequest:
+		return nil, fmt.Errorf("Bad request for getting %s of artifact: %s/%s@%s", addition, projectName, repoName, reference)
+	case http.StatusForbidden:
+		return nil, fmt.Errorf("Forbidden to get %s of artifact: %s/%s@%s", addition, projectName, repoName, reference)
+	case http.StatusInternalServerError:
+		return nil, fmt.Errorf("Internal server error occurred while getting %s of artifact: %s/%s@%s", addition, projectName, repoName, reference)
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("Artifact %s/%s@%s not found or has no %s", projectName, repoName, reference, addition)
+	case http.StatusUnauthorized:
+		return nil, fmt.Errorf("Unauthorized to get %s of artifact: %s/%s@%s", addition, projectName, repoName, reference)
+	default:
+		return nil, fmt.Errorf("Unknown error occurred while getting %s of artifact: %s", addition, resp.Status)
+	}
+}
+
+// GetVulnerabilities retrieves the vulnerability report of an artifact.
+// The returned bool is false when the artifact has not been scanned.
+func GetVulnerabilities(projectName, repoName, reference string) (VulnerabilityReport, bool, error) {
+	content, err := GetAddition(projectName, repoName, reference, "vulnerabilities")
+	if err != nil {
+		return VulnerabilityReport{}, false, err
+	}
+	return parseVulnerabilities(content)
+}
+
+// parseVulnerabilities parses the vulnerabilities addition, an object keyed
+// by the mime type of the scanner report which is empty before a scan.
+func parseVulnerabilities(content []byte) (VulnerabilityReport, bool, error) {
+	var reports map[string]VulnerabilityReport
+	if err := json.Unmarshal(content, &reports); err != nil {
+		return VulnerabilityReport{}, false, fmt.Errorf("Failed to parse vulnerability report: %v", err)
+	}
+	for _, report := range reports {
+		return report, true, nil
+	}
+
+	return VulnerabilityReport{}, false, nil
+}
+
+// ListChildArtifacts retrieves the artifacts referenced by an image index
+// together with their scan overview.
+func ListChildArtifacts(projectName, repoName string, index *models.Artifact) ([]*models.Artifact, error) {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return nil, fmt.Errorf("Failed to initialize client context")
+	}
+
+	withScanOverview := true
+	children := make([]*models.Artifact, 0, len(index.References))
+	for _, ref := range index.References {
+		response, err := client.Artifact.GetArtifact(ctx, &artifact.GetArtifactParams{
+			ProjectName:      projectName,
+			RepositoryName:   repoName,
+			Reference:        ref.ChildDigest,
+			WithScanOverview: &withScanOverview,
+		})
+		if err != nil {
+			switch err.(type) {
+			case *artifact.GetArtifactForbidden:
+				return nil, fmt.Errorf("Forbidden to retrieve artifact: %s/%s@%s", projectName, repoName, ref.ChildDigest)
+			case *artifact.GetArtifactInternalServerError:
+				return nil, fmt.Errorf("Internal server error occurred while retrieving artifact: %s/%s@%s", projectName, repoName, ref.ChildDigest)
+			case *artifact.GetArtifactNotFound:
+				return nil, fmt.Errorf("Artifact not found: %s/%s@%s", projectName, repoName, ref.ChildDigest)
+			case *artifact.GetArtifactUnauthorized:
+				return nil, fmt.Errorf("Unauthorized to retrieve artifact: %s/%s@%s", projectName, repoName, ref.ChildDigest)
+			default:
+				return nil, fmt.Errorf("Unknown error occurred while retrieving artifact info: %v", err)
+			}
+		}
+		children = append(children, response.Payload)
+	}
+
+	return children, nil
+}
@@ -0,0 +1,98 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAddition(t *testing.T) {
+	additions := map[string]struct {
+		contentType string
+		body        string
+	}{
+		"build_history": {"application/json", `[{"created":"2024-05-01T10:00:00Z","created_by":"/bin/sh -c #(nop) ADD file:abc in /"}]`},
+		"dependencies":  {"application/json", `[{"name":"redis","version":"17.0.0","repository":"https://charts.bitnami.com/bitnami"}]`},
+		"readme":        {"text/markdown; charset=utf-8", "# Chart\n\nInstalls the app.\n"},
+		"values":        {"text/plain; charset=utf-8", "replicaCount: 1\n"},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		if !ok || username != "admin" || password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		const prefix = "/api/v2.0/projects/library/repositories/team%252Fapp/artifacts/sha256:abc/additions/"
+		name, found := strings.CutPrefix(r.URL.EscapedPath(), prefix)
+		addition, ok := additions[name]
+		if !found || !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", addition.contentType)
+		_, _ = w.Write([]byte(addition.body))
+	}))
+	defer server.Close()
+
+	credential := utils.Credential{ServerAddress: server.URL, Username: "admin", Password: "secret"}
+	for name, addition := range additions {
+		t.Run(name, func(t *testing.T) {
+			content, err := getAddition(server.Client(), credential, "library", "team/app", "sha256:abc", name)
+			assert.NoError(t, err)
+			assert.Equal(t, addition.body, string(content))
+		})
+	}
+
+	t.Run("missing addition", func(t *testing.T) {
+		_, err := getAddition(server.Client(), credential, "library", "team/app", "sha256:abc", "icon")
+		assert.ErrorContains(t, err, "not found or has no icon")
+	})
+
+	t.Run("wrong credentials", func(t *testing.T) {
+		wrong := credential
+		wrong.Password = "wrong"
+		_, err := getAddition(server.Client(), wrong, "library", "team/app", "sha256:abc", "readme")
+		assert.ErrorContains(t, err, "Unauthorized")
+	})
+}
+
+func TestParseVulnerabilities(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		wantScanned bool
+		wantIDs     []string
+		wantErr     bool
+	}{
+		{
+			name:        "scanned",
+			content:     `{"application/vnd.security.vulnerability.report; version=1.1":{"generated_at":"2024-05-01T10:00:00Z","severity":"High","vulnerabilities":[{"id":"CVE-2023-44487","package":"golang.org/x/net","version":"0.7.0","fix_version":"0.17.0","severity":"High"}]}}`,
+			wantScanned: true,
+			wantIDs:     []string{"CVE-2023-44487"},
+		},
+		{name: "not scanned", content: `{}`},
+		{name: "not an object", content: `[]`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, scanned, err := parseVulnerabilities([]byte(tt.content))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantScanned, scanned)
+			var ids []string
+			for _, v := range report.Vulnerabilities {
+				ids = append(ids, v.ID)
+			}
+			assert.Equal(t, tt.wantIDs, ids)
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package api
+
+import (
+	"fmt"
+
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/client/project"
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/client/system_cve_allowlist"
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+func GetSystemCVEAllowlist() (*models.CVEAllowlist, error) {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize client context")
+	}
+
+	response, err := client.SystemCVEAllowlist.GetSystemCVEAllowlist(ctx, &system_cve_allowlist.GetSystemCVEAllowlistParams{})
+	if err != nil {
+		switch err.(type) {
+		case *system_cve_allowlist.GetSystemCVEAllowlistUnauthorized:
+			return nil, fmt.Errorf("unauthorized to get the system CVE allowlist")
+		case *system_cve_allowlist.GetSystemCVEAllowlistInternalServerError:
+			return nil, fmt.Errorf("internal server error occurred while getting the system CVE allowlist")
+		default:
+			return nil, fmt.Errorf("unknown error occurred while getting the system CVE allowlist: %v", err)
+		}
+	}
+
+	return response.Payload, nil
+}
+
+func UpdateSystemCVEAllowlist(allowlist *models.CVEAllowlist) error {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return fmt.Errorf("failed to initialize client context")
+	}
+
+	_, err = client.SystemCVEAllowlist.PutSystemCVEAllowlist(ctx, &system_cve_allowlist.PutSystemCVEAllowlistParams{
+		Allowlist: allowlist,
+	})
+	if err != nil {
+		switch err.(type) {
+		case *system_cve_allowlist.PutSystemCVEAllowlistUnauthorized:
+			return fmt.Errorf("unauthorized to update the system CVE allowlist")
+		case *system_cve_allowlist.PutSystemCVEAllowlistForbidden:
+			return fmt.Errorf("forbidden to update the system CVE allowlist, system admin privileges are required")
+		case *system_cve_allowlist.PutSystemCVEAllowlistInternalServerError:
+			return fmt.Errorf("internal server error occurred while updating the system CVE allowlist")
+		default:
+			return fmt.Errorf("unknown error occurred while updating the system CVE allowlist: %v", err)
+		}
+	}
+
+	log.Infof("System CVE allowlist updated successfully")
+	return nil
+}
+
+// UpdateProjectCVEAllowlist replaces the CVE allowlist of a project. With
+// reuseSystem the project falls back to the system allowlist instead.
+func UpdateProjectCVEAllowlist(projectName string, allowlist *models.CVEAllowlist, reuseSystem bool) error {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return fmt.Errorf("failed to initialize client context")
+	}
+
+	reuse := fmt.Sprintf("%t", reuseSystem)
+	_, err = client.Project.UpdateProject(ctx, &project.UpdateProjectParams{
+		ProjectNameOrID: projectName,
+		Project: &models.ProjectReq{
+			CVEAllowlist: allowlist,
+			Metadata:     &models.ProjectMetadata{ReuseSysCVEAllowlist: &reuse},
+		},
+	})
+	if err != nil {
+		switch err.(type) {
+		case *project.UpdateProjectBadRequest:
+			return fmt.Errorf("invalid CVE allowlist for project %s", projectName)
+		case *project.UpdateProjectNotFound:
+			return fmt.Errorf("project %s not found", projectName)
+		case *project.UpdateProjectForbidden:
+			return fmt.Errorf("forbidden to update the CVE allowlist of project %s", projectName)
+		case *project.UpdateProjectUnauthorized:
+			return fmt.Errorf("unauthorized to update the CVE allowlist of project %s", projectName)
+		case *project.UpdateProjectInternalServerError:
+			return fmt.Errorf("internal server error occurred while updating the CVE allowlist of project %s", projectName)
+		default:
+			return fmt.Errorf("unknown error occurred while updating the CVE allowlist of project %s: %v", projectName, err)
+		}
+	}
+
+	log.Infof("CVE allowlist of project %s updated successfully", projectName)
+	return nil
+}
@@ -0,0 +1,192 @@
+package api
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/client/gc"
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// RunGC starts a garbage collection job and returns its ID.
+func RunGC(parameters map[string]interface{}) (int64, error) {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return 0, fmt.Errorf("failed to initialize client context")
+	}
+
+	response, err := client.GC.CreateGCSchedule(ctx, &gc.CreateGCScheduleParams{
+		Schedule: &models.Schedule{
+			Schedule:   &models.ScheduleObj{Type: "Manual"},
+			Parameters: parameters,
+		},
+	})
+	if err != nil {
+		switch e := err.(type) {
+		case *gc.CreateGCScheduleBadRequest:
+			return 0, fmt.Errorf("cannot start GC: %s", errorMessages(e.Payload))
+		case *gc.CreateGCScheduleConflict:
+			return 0, fmt.Errorf("a GC job is already running")
+		case *gc.CreateGCScheduleUnauthorized:
+			return 0, fmt.Errorf("unauthorized to start GC")
+		case *gc.CreateGCScheduleForbidden:
+			return 0, fmt.Errorf("forbidden to start GC, system admin privileges are required")
+		case *gc.CreateGCScheduleInternalServerError:
+			return 0, fmt.Errorf("internal server error occurred while starting GC")
+		default:
+			return 0, fmt.Errorf("unknown error occurred while starting GC: %v", err)
+		}
+	}
+
+	// The location of the new GC job ends with its ID.
+	location := response.Location
+	gcID, err := strconv.ParseInt(location[strings.LastIndex(location, "/")+1:], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("unexpected location of the started GC job: %q", location)
+	}
+	return gcID, nil
+}
+
+func GetGC(gcID int64) (*models.GCHistory, error) {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize client context")
+	}
+
+	response, err := client.GC.GetGC(ctx, &gc.GetGCParams{GCID: gcID})
+	if err != nil {
+		switch err.(type) {
+		case *gc.GetGCNotFound:
+			return nil, fmt.Errorf("GC job %d not found", gcID)
+		case *gc.GetGCUnauthorized:
+			return nil, fmt.Errorf("unauthorized to get GC job %d", gcID)
+		case *gc.GetGCForbidden:
+			return nil, fmt.Errorf("forbidden to get GC job %d", gcID)
+		case *gc.GetGCInternalServerError:
+			return nil, fmt.Errorf("internal server error occurred while getting GC job %d", gcID)
+		default:
+			return nil, fmt.Errorf("unknown error occurred while getting GC job %d: %v", gcID, err)
+		}
+	}
+
+	return response.Payload, nil
+}
+
+func ListGCHistory(opts ListFlags) ([]*models.GCHistory, error) {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize client context")
+	}
+
+	response, err := client.GC.GetGCHistory(ctx, &gc.GetGCHistoryParams{
+		Page:     &opts.Page,
+		PageSize: &opts.PageSize,
+		Q:        &opts.Q,
+		Sort:     &opts.Sort,
+	})
+	if err != nil {
+		switch err.(type) {
+		case *gc.GetGCHistoryUnauthorized:
+			return nil, fmt.Errorf("unauthorized to list GC history")
+		case *gc.GetGCHistoryForbidden:
+			return nil, fmt.Errorf("forbidden to list GC history")
+		case *gc.GetGCHistoryInternalServerError:
+			return nil, fmt.Errorf("internal server error occurred while listing GC history")
+		default:
+			return nil, fmt.Errorf("unknown error occurred while listing GC history: %v", err)
+		}
+	}
+
+	return response.Payload, nil
+}
+
+func GetGCLog(gcID int64) (string, error) {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return "", fmt.Errorf("failed to initialize client context")
+	}
+
+	response, err := client.GC.GetGCLog(ctx, &gc.GetGCLogParams{GCID: gcID})
+	if err != nil {
+		switch err.(type) {
+		case *gc.GetGCLogNotFound:
+			return "", fmt.Errorf("log of GC job %d not found", gcID)
+		case *gc.GetGCLogBadRequest:
+			return "", fmt.Errorf("invalid GC job ID %d", gcID)
+		case *gc.GetGCLogUnauthorized:
+			return "", fmt.Errorf("unauthorized to get the log of GC job %d", gcID)
+		case *gc.GetGCLogForbidden:
+			return "", fmt.Errorf("forbidden to get the log of GC job %d", gcID)
+		case *gc.GetGCLogInternalServerError:
+			return "", fmt.Errorf("internal server error occurred while getting the log of GC job %d", gcID)
+		default:
+			return "", fmt.Errorf("unknown error occurred while getting the log of GC job %d: %v", gcID, err)
+		}
+	}
+
+	return response.Payload, nil
+}
+
+// GetGCSchedule returns the GC schedule, whose Schedule is unset or of type
+// None when GC does not run periodically.
+func GetGCSchedule() (*models.GCHistory, error) {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize client context")
+	}
+
+	response, err := client.GC.GetGCSchedule(ctx, &gc.GetGCScheduleParams{})
+	if err != nil {
+		switch err.(type) {
+		case *gc.GetGCScheduleUnauthorized:
+			return nil, fmt.Errorf("unauthorized to get the GC schedule")
+		case *gc.GetGCScheduleForbidden:
+			return nil, fmt.Errorf("forbidden to get the GC schedule")
+		case *gc.GetGCScheduleInternalServerError:
+			return nil, fmt.Errorf("internal server error occurred while getting the GC schedule")
+		default:
+			return nil, fmt.Errorf("unknown error occurred while getting the GC schedule: %v", err)
+		}
+	}
+
+	return response.Payload, nil
+}
+
+// SetGCSchedule replaces the GC schedule. Harbor only updates an existing
+// schedule, so create tells whether there is one yet.
+func SetGCSchedule(schedule *models.Schedule, create bool) error {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return fmt.Errorf("failed to initialize client context")
+	}
+
+	if create {
+		_, err = client.GC.CreateGCSchedule(ctx, &gc.CreateGCScheduleParams{Schedule: schedule})
+	} else {
+		_, err = client.GC.UpdateGCSchedule(ctx, &gc.UpdateGCScheduleParams{Schedule: schedule})
+	}
+	if err != nil {
+		switch e := err.(type) {
+		case *gc.CreateGCScheduleBadRequest:
+			return fmt.Errorf("invalid GC schedule: %s", errorMessages(e.Payload))
+		case *gc.UpdateGCScheduleBadRequest:
+			return fmt.Errorf("invalid GC schedule: %s", errorMessages(e.Payload))
+		case *gc.CreateGCScheduleConflict:
+			return fmt.Errorf("a GC schedule already exists")
+		case *gc.CreateGCScheduleUnauthorized, *gc.UpdateGCScheduleUnauthorized:
+			return fmt.Errorf("unauthorized to set the GC schedule")
+		case *gc.CreateGCScheduleForbidden, *gc.UpdateGCScheduleForbidden:
+			return fmt.Errorf("forbidden to set the GC schedule, system admin privileges are required")
+		case *gc.CreateGCScheduleInternalServerError, *gc.UpdateGCScheduleInternalServerError:
+			return fmt.Errorf("internal server error occurred while setting the GC schedule")
+		default:
+			return fmt.Errorf("unknown error occurred while setting the GC schedule: %v", err)
+		}
+	}
+
+	log.Infof("GC schedule updated successfully")
+	return nil
+}
@@ -0,0 +1,185 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/client/immutable"
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// ErrTagImmutable is returned when a tag cannot be deleted because an
+// immutable rule protects it.
+var ErrTagImmutable = errors.New("tag is immutable")
+
+// ListImmutableRules lists the immutable tag rules of a project.
+func ListImmutableRules(projectName string) ([]*models.ImmutableRule, error) {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize client context")
+	}
+
+	var rules []*models.ImmutableRule
+	page, pageSize := int64(1), int64(100)
+	for {
+		response, err := client.Immutable.ListImmuRules(ctx, &immutable.ListImmuRulesParams{
+			ProjectNameOrID: projectName,
+			Page:            &page,
+			PageSize:        &pageSize,
+		})
+		if err != nil {
+			switch err.(type) {
+			case *immutable.ListImmuRulesBadRequest:
+				return nil, fmt.Errorf("bad request while listing immutable rules of project %s", projectName)
+			case *immutable.ListImmuRulesUnauthorized:
+				return nil, fmt.Errorf("unauthorized to list immutable rules of project %s", projectName)
+			case *immutable.ListImmuRulesForbidden:
+				return nil, fmt.Errorf("forbidden to list immutable rules of project %s", projectName)
+			case *immutable.ListImmuRulesInternalServerError:
+				return nil, fmt.Errorf("internal server error occurred while listing immutable rules of project %s", projectName)
+			default:
+				return nil, fmt.Errorf("unknown error occurred while listing immutable rules of project %s: %v", projectName, err)
+			}
+		}
+		rules = append(rules, response.Payload...)
+		if int64(len(response.Payload)) < pageSize {
+			break
+		}
+		page++
+	}
+
+	return rules, nil
+}
+
+func CreateImmutableRule(projectName string, rule *models.ImmutableRule) error {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return fmt.Errorf("failed to initialize client context")
+	}
+
+	_, err = client.Immutable.CreateImmuRule(ctx, &immutable.CreateImmuRuleParams{
+		ProjectNameOrID: projectName,
+		ImmutableRule:   rule,
+	})
+	if err != nil {
+		switch err.(type) {
+		case *immutable.CreateImmuRuleBadRequest:
+			return fmt.Errorf("invalid immutable rule for project %s", projectName)
+		case *immutable.CreateImmuRuleUnauthorized:
+			return fmt.Errorf("unauthorized to create immutable rule in project %s", projectName)
+		case *immutable.CreateImmuRuleForbidden:
+			return fmt.Errorf("forbidden to create immutable rule in project %s", projectName)
+		case *immutable.CreateImmuRuleNotFound:
+			return fmt.Errorf("project %s not found", projectName)
+		case *immutable.CreateImmuRuleInternalServerError:
+			return fmt.Errorf("internal server error occurred while creating immutable rule in project %s", projectName)
+		default:
+			return fmt.Errorf("unknown error occurred while creating immutable rule in project %s: %v", projectName, err)
+		}
+	}
+
+	log.Infof("immutable rule created successfully in project %s", projectName)
+	return nil
+}
+
+func UpdateImmutableRule(projectName string, rule *models.ImmutableRule) error {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return fmt.Errorf("failed to initialize client context")
+	}
+
+	_, err = client.Immutable.UpdateImmuRule(ctx, &immutable.UpdateImmuRuleParams{
+		ProjectNameOrID: projectName,
+		ImmutableRuleID: rule.ID,
+		ImmutableRule:   rule,
+	})
+	if err != nil {
+		switch err.(type) {
+		case *immutable.UpdateImmuRuleBadRequest:
+			return fmt.Errorf("invalid immutable rule %d", rule.ID)
+		case *immutable.UpdateImmuRuleUnauthorized:
+			return fmt.Errorf("unauthorized to update immutable rule %d", rule.ID)
+		case *immutable.UpdateImmuRuleForbidden:
+			return fmt.Errorf("forbidden to update immutable rule %d", rule.ID)
+		case *immutable.UpdateImmuRuleInternalServerError:
+			return fmt.Errorf("internal server error occurred while updating immutable rule %d", rule.ID)
+		default:
+			return fmt.Errorf("unknown error occurred while updating immutable rule %d: %v", rule.ID, err)
+		}
+	}
+
+	log.Infof("immutable rule %d updated successfully", rule.ID)
+	return nil
+}
+
+func DeleteImmutableRule(projectName string, ruleID int64) error {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return fmt.Errorf("failed to initialize client context")
+	}
+
+	_, err = client.Immutable.DeleteImmuRule(ctx, &immutable.DeleteImmuRuleParams{
+		ProjectNameOrID: projectName,
+		ImmutableRuleID: ruleID,
+	})
+	if err != nil {
+		switch err.(type) {
+		case *immutable.DeleteImmuRuleBadRequest:
+			return fmt.Errorf("bad request while deleting immutable rule %d", ruleID)
+		case *immutable.DeleteImmuRuleUnauthorized:
+			return fmt.Errorf("unauthorized to delete immutable rule %d", ruleID)
+		case *immutable.DeleteImmuRuleForbidden:
+			return fmt.Errorf("forbidden to delete immutable rule %d", ruleID)
+		case *immutable.DeleteImmuRuleInternalServerError:
+			return fmt.Errorf("internal server error occurred while deleting immutable rule %d", ruleID)
+		default:
+			return fmt.Errorf("unknown error occurred while deleting immutable rule %d: %v", ruleID, err)
+		}
+	}
+
+	log.Infof("immutable rule %d deleted successfully", ruleID)
+	return nil
+}
+
+// FindImmutableRules returns the enabled immutable rules of a project that
+// protect the given tag of a repository.
+func FindImmutableRules(projectName, repoName, tag string) ([]*models.ImmutableRule, error) {
+	rules, err := ListImmutableRules(projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var matching []*models.ImmutableRule
+	for _, rule := range rules {
+		if rule.Disabled {
+			continue
+		}
+		if selectorsMatch(rule.ScopeSelectors["repository"], repoName) && selectorsMatch(derefSelectors(rule.TagSelectors), tag) {
+			matching = append(matching, rule)
+		}
+	}
+	return matching, nil
+}
+
+func selectorsMatch(selectors []models.ImmutableSelector, name string) bool {
+	for _, selector := range selectors {
+		matches := utils.MatchDoublestar(selector.Pattern, name)
+		if selector.Decoration == "excludes" || selector.Decoration == "repoExcludes" {
+			matches = !matches
+		}
+		if !matches {
+			return false
+		}
+	}
+	return true
+}
+
+func derefSelectors(selectors []*models.ImmutableSelector) []models.ImmutableSelector {
+	var result []models.ImmutableSelector
+	for _, selector := range selectors {
+		result = append(result, *selector)
+	}
+	return result
+}
@@ -84,6 +84,9 @@ func ListLabel(opts ...ListFlags) (*label.ListLabelsOK, error) {
 		listFlags = opts[0]
 	}
 	scope := "g"
+	if listFlags.Scope != "" {
+		scope = listFlags.Scope
+	}
 	response, err := client.Label.ListLabels(ctx, &label.ListLabelsParams{
 		Page:      &listFlags.Page,
 		PageSize:  &listFlags.PageSize,
@@ -158,19 +161,55 @@ func GetLabel(labelid int64) *models.Label {
 	return response.GetPayload()
 }
 
-func GetLabelIdByName(labelName string) (int64, error) {
-	var opts ListFlags
-
-	l, err := ListLabel(opts)
-	if err != nil {
-		return 0, fmt.Errorf("failed to list labels: %v", err)
+// GetLabelIdByName resolves a label name to its ID. Global labels are
+// searched by default, pass ListFlags with Scope "p" and a ProjectID to
+// resolve a project label instead.
+func GetLabelIdByName(labelName string, opts ...ListFlags) (int64, error) {
+	var listFlags ListFlags
+	if len(opts) > 0 {
+		listFlags = opts[0]
+	}
+	if listFlags.Page == 0 {
+		listFlags.Page = 1
+	}
+	if listFlags.PageSize == 0 {
+		listFlags.PageSize = 100
 	}
 
-	for _, label := range l.Payload {
-		if label.Name == labelName {
-			return label.ID, nil
+	for {
+		l, err := ListLabel(listFlags)
+		if err != nil {
+			return 0, fmt.Errorf("failed to list labels: %v", err)
 		}
+
+		for _, label := range l.Payload {
+			if label.Name == labelName {
+				return label.ID, nil
+			}
+		}
+
+		if int64(len(l.Payload)) < listFlags.PageSize {
+			break
+		}
+		listFlags.Page++
 	}
 
 	return 0, fmt.Errorf("label with name '%s' not found", labelName)
 }
+
+// GetProjectOrGlobalLabelIdByName resolves a label name in the scope of a
+// project, labels defined in the project take precedence over global ones.
+func GetProjectOrGlobalLabelIdByName(projectName, labelName string) (int64, error) {
+	project, err := GetProject(projectName)
+	if err == nil {
+		labelId, err := GetLabelIdByName(labelName, ListFlags{
+			Scope:     "p",
+			ProjectID: int64(project.Payload.ProjectID),
+		})
+		if err == nil {
+			return labelId, nil
+		}
+	}
+
+	return GetLabelIdByName(labelName)
+}
@@ -0,0 +1,167 @@
+package api
+
+import (
+	"fmt"
+
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/client/member"
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/client/usergroup"
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// ListMembers lists all members of a project.
+func ListMembers(projectName string) ([]*models.ProjectMemberEntity, error) {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return nil, fmt.Errorf("Failed to initialize client context")
+	}
+
+	var members []*models.ProjectMemberEntity
+	page, pageSize := int64(1), int64(100)
+	for {
+		response, err := client.Member.ListProjectMembers(ctx, &member.ListProjectMembersParams{
+			ProjectNameOrID: projectName,
+			Page:            &page,
+			PageSize:        &pageSize,
+		})
+		if err != nil {
+			switch err.(type) {
+			case *member.ListProjectMembersBadRequest:
+				return nil, fmt.Errorf("Bad request for listing members of project %s", projectName)
+			case *member.ListProjectMembersUnauthorized:
+				return nil, fmt.Errorf("Unauthorized to list members of project %s", projectName)
+			case *member.ListProjectMembersForbidden:
+				return nil, fmt.Errorf("Forbidden to list members of project %s", projectName)
+			case *member.ListProjectMembersNotFound:
+				return nil, fmt.Errorf("Project %s not found", projectName)
+			case *member.ListProjectMembersInternalServerError:
+				return nil, fmt.Errorf("Internal server error occurred while listing members of project %s", projectName)
+			default:
+				return nil, fmt.Errorf("Unknown error occurred while listing members of project %s: %v", projectName, err)
+			}
+		}
+		members = append(members, response.Payload...)
+		if int64(len(response.Payload)) < pageSize {
+			break
+		}
+		page++
+	}
+
+	return members, nil
+}
+
+// AddMember adds a user or group to a project.
+func AddMember(projectName string, projectMember *models.ProjectMember) error {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return fmt.Errorf("Failed to initialize client context")
+	}
+
+	_, err = client.Member.CreateProjectMember(ctx, &member.CreateProjectMemberParams{
+		ProjectNameOrID: projectName,
+		ProjectMember:   projectMember,
+	})
+	if err != nil {
+		switch err.(type) {
+		case *member.CreateProjectMemberBadRequest:
+			return fmt.Errorf("Bad request for adding member to project %s", projectName)
+		case *member.CreateProjectMemberUnauthorized:
+			return fmt.Errorf("Unauthorized to add member to project %s", projectName)
+		case *member.CreateProjectMemberForbidden:
+			return fmt.Errorf("Forbidden to add member to project %s", projectName)
+		case *member.CreateProjectMemberConflict:
+			return fmt.Errorf("Member is already in project %s", projectName)
+		case *member.CreateProjectMemberInternalServerError:
+			return fmt.Errorf("Internal server error occurred while adding member to project %s", projectName)
+		default:
+			return fmt.Errorf("Unknown error occurred while adding member to project %s: %v", projectName, err)
+		}
+	}
+
+	log.Infof("Member added to project %s successfully", projectName)
+	return nil
+}
+
+// UpdateMemberRole changes the role of a project member.
+func UpdateMemberRole(projectName string, memberID, roleID int64) error {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return fmt.Errorf("Failed to initialize client context")
+	}
+
+	_, err = client.Member.UpdateProjectMember(ctx, &member.UpdateProjectMemberParams{
+		ProjectNameOrID: projectName,
+		Mid:             memberID,
+		Role:            &models.RoleRequest{RoleID: roleID},
+	})
+	if err != nil {
+		switch err.(type) {
+		case *member.UpdateProjectMemberBadRequest:
+			return fmt.Errorf("Bad request for updating member %d of project %s", memberID, projectName)
+		case *member.UpdateProjectMemberUnauthorized:
+			return fmt.Errorf("Unauthorized to update member %d of project %s", memberID, projectName)
+		case *member.UpdateProjectMemberForbidden:
+			return fmt.Errorf("Forbidden to update member %d of project %s", memberID, projectName)
+		case *member.UpdateProjectMemberNotFound:
+			return fmt.Errorf("Member %d not found in project %s", memberID, projectName)
+		case *member.UpdateProjectMemberInternalServerError:
+			return fmt.Errorf("Internal server error occurred while updating member %d of project %s", memberID, projectName)
+		default:
+			return fmt.Errorf("Unknown error occurred while updating member %d of project %s: %v", memberID, projectName, err)
+		}
+	}
+
+	log.Infof("Member of project %s updated successfully", projectName)
+	return nil
+}
+
+// RemoveMember removes a member from a project.
+func RemoveMember(projectName string, memberID int64) error {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return fmt.Errorf("Failed to initialize client context")
+	}
+
+	_, err = client.Member.DeleteProjectMember(ctx, &member.DeleteProjectMemberParams{
+		ProjectNameOrID: projectName,
+		Mid:             memberID,
+	})
+	if err != nil {
+		switch err.(type) {
+		case *member.DeleteProjectMemberBadRequest:
+			return fmt.Errorf("Bad request for removing member %d from project %s", memberID, projectName)
+		case *member.DeleteProjectMemberUnauthorized:
+			return fmt.Errorf("Unauthorized to remove member %d from project %s", memberID, projectName)
+		case *member.DeleteProjectMemberForbidden:
+			return fmt.Errorf("Forbidden to remove member %d from project %s", memberID, projectName)
+		case *member.DeleteProjectMemberInternalServerError:
+			return fmt.Errorf("Internal server error occurred while removing member %d from project %s", memberID, projectName)
+		default:
+			return fmt.Errorf("Unknown error occurred while removing member %d from project %s: %v", memberID, projectName, err)
+		}
+	}
+
+	log.Infof("Member removed from project %s successfully", projectName)
+	return nil
+}
+
+// GetUserGroupIdByName returns the ID of the user group with the given name.
+func GetUserGroupIdByName(groupName string) (int64, error) {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return 0, fmt.Errorf("Failed to initialize client context")
+	}
+
+	response, err := client.Usergroup.SearchUserGroups(ctx, &usergroup.SearchUserGroupsParams{Groupname: groupName})
+	if err != nil {
+		return 0, fmt.Errorf("failed to search user groups for %s: %v", groupName, err)
+	}
+	for _, group := range response.Payload {
+		if group.GroupName == groupName {
+			return group.ID, nil
+		}
+	}
+
+	return 0, fmt.Errorf("user group not found: %s", groupName)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
 	"github.com/goharbor/harbor-cli/pkg/utils"
 	"github.com/goharbor/harbor-cli/pkg/views/project/create"
+	"github.com/goharbor/harbor-cli/pkg/views/project/update"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -80,6 +81,33 @@ func GetProject(projectName string) (*project.GetProjectOK, error) {
 	return response, nil
 }
 
+// GetProjectSummary returns the summary of a project, including its quota,
+// which unlike the quota API is readable by the members of the project.
+func GetProjectSummary(projectName string) (*models.ProjectSummary, error) {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return nil, fmt.Errorf("Failed to initialize client context for getting the summary of project %s", projectName)
+	}
+
+	response, err := client.Project.GetProjectSummary(ctx, &project.GetProjectSummaryParams{ProjectNameOrID: projectName})
+	if err != nil {
+		switch err.(type) {
+		case *project.GetProjectSummaryNotFound:
+			return nil, fmt.Errorf("Project %s not found", projectName)
+		case *project.GetProjectSummaryUnauthorized:
+			return nil, fmt.Errorf("Unauthorized to get the summary of project %s", projectName)
+		case *project.GetProjectSummaryForbidden:
+			return nil, fmt.Errorf("Insufficient permissions to get the summary of project %s", projectName)
+		case *project.GetProjectSummaryInternalServerError:
+			return nil, fmt.Errorf("Internal server error occurred while getting the summary of project %s", projectName)
+		default:
+			return nil, fmt.Errorf("Unknown error occurred while getting the summary of project %s: %v", projectName, err)
+		}
+	}
+
+	return response.Payload, nil
+}
+
 func DeleteProject(projectName string, forceDelete bool) error {
 	ctx, client, err := utils.ContextWithClient()
 	if err != nil {
@@ -224,3 +252,55 @@ func LogsProject(projectName string) (*project.GetLogsOK, error) {
 
 	return response, nil
 }
+
+func UpdateProject(projectName string, opts update.UpdateView) error {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return fmt.Errorf("Failed to initialize client context for updating project %s", projectName)
+	}
+
+	autoScan := strconv.FormatBool(opts.AutoScan)
+	preventVul := strconv.FormatBool(opts.PreventVul)
+	contentTrust := strconv.FormatBool(opts.ContentTrust)
+	contentTrustCosign := strconv.FormatBool(opts.ContentTrustCosign)
+	reuseSysCVEAllowlist := strconv.FormatBool(opts.ReuseSysCVEAllowlist)
+	var severity *string
+	if opts.Severity != "" {
+		severity = &opts.Severity
+	}
+
+	_, err = client.Project.UpdateProject(ctx, &project.UpdateProjectParams{
+		ProjectNameOrID: projectName,
+		Project: &models.ProjectReq{
+			Public: &opts.Public,
+			Metadata: &models.ProjectMetadata{
+				Public:                   strconv.FormatBool(opts.Public),
+				AutoScan:                 &autoScan,
+				PreventVul:               &preventVul,
+				Severity:                 severity,
+				EnableContentTrust:       &contentTrust,
+				EnableContentTrustCosign: &contentTrustCosign,
+				ReuseSysCVEAllowlist:     &reuseSysCVEAllowlist,
+			},
+		},
+	})
+	if err != nil {
+		switch err.(type) {
+		case *project.UpdateProjectBadRequest:
+			return fmt.Errorf("Invalid request to update project %s", projectName)
+		case *project.UpdateProjectNotFound:
+			return fmt.Errorf("Project %s not found", projectName)
+		case *project.UpdateProjectForbidden:
+			return fmt.Errorf("Insufficient permissions to update project %s", projectName)
+		case *project.UpdateProjectUnauthorized:
+			return fmt.Errorf("Unauthorized to update project %s", projectName)
+		case *project.UpdateProjectInternalServerError:
+			return fmt.Errorf("Internal server error occurred while updating project %s", projectName)
+		default:
+			return fmt.Errorf("Unknown error occurred while updating project %s: %v", projectName, err)
+		}
+	}
+
+	log.Infof("Project %s updated successfully", projectName)
+	return nil
+}
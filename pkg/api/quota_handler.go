@@ -0,0 +1,140 @@
+package api
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/client/quota"
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// GetProjectQuota returns the quota of the project with the given ID.
+func GetProjectQuota(projectID int64) (*models.Quota, error) {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize client context")
+	}
+
+	reference := "project"
+	referenceID := strconv.FormatInt(projectID, 10)
+	response, err := client.Quota.ListQuotas(ctx, &quota.ListQuotasParams{
+		Reference:   &reference,
+		ReferenceID: &referenceID,
+	})
+	if err != nil {
+		switch err.(type) {
+		case *quota.ListQuotasUnauthorized:
+			return nil, fmt.Errorf("unauthorized to get quota of project %d", projectID)
+		case *quota.ListQuotasForbidden:
+			return nil, fmt.Errorf("forbidden to get quota of project %d", projectID)
+		case *quota.ListQuotasInternalServerError:
+			return nil, fmt.Errorf("internal server error occurred while getting quota of project %d", projectID)
+		default:
+			return nil, fmt.Errorf("unknown error occurred while getting quota of project %d: %v", projectID, err)
+		}
+	}
+	if len(response.Payload) == 0 {
+		return nil, fmt.Errorf("no quota found for project %d", projectID)
+	}
+
+	return response.Payload[0], nil
+}
+
+// UpdateStorageQuota sets the storage limit in bytes of a quota, -1 for unlimited.
+func UpdateStorageQuota(quotaID, storage int64) error {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return fmt.Errorf("failed to initialize client context")
+	}
+
+	_, err = client.Quota.UpdateQuota(ctx, &quota.UpdateQuotaParams{
+		ID:   quotaID,
+		Hard: &models.QuotaUpdateReq{Hard: models.ResourceList{"storage": storage}},
+	})
+	if err != nil {
+		switch err.(type) {
+		case *quota.UpdateQuotaBadRequest:
+			return fmt.Errorf("invalid storage limit %d for quota %d", storage, quotaID)
+		case *quota.UpdateQuotaUnauthorized:
+			return fmt.Errorf("unauthorized to update quota %d", quotaID)
+		case *quota.UpdateQuotaForbidden:
+			return fmt.Errorf("forbidden to update quota %d", quotaID)
+		case *quota.UpdateQuotaNotFound:
+			return fmt.Errorf("quota %d not found", quotaID)
+		case *quota.UpdateQuotaInternalServerError:
+			return fmt.Errorf("internal server error occurred while updating quota %d", quotaID)
+		default:
+			return fmt.Errorf("unknown error occurred while updating quota %d: %v", quotaID, err)
+		}
+	}
+
+	log.Infof("Storage quota updated successfully")
+	return nil
+}
+
+// ListQuotas lists the project quotas across all pages, ordered by sort
+// such as "-used.storage".
+func ListQuotas(sort string) ([]*models.Quota, error) {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize client context")
+	}
+
+	var quotas []*models.Quota
+	reference := "project"
+	page, pageSize := int64(1), int64(100)
+	for {
+		response, err := client.Quota.ListQuotas(ctx, &quota.ListQuotasParams{
+			Page:      &page,
+			PageSize:  &pageSize,
+			Reference: &reference,
+			Sort:      &sort,
+		})
+		if err != nil {
+			switch err.(type) {
+			case *quota.ListQuotasUnauthorized:
+				return nil, fmt.Errorf("unauthorized to list quotas")
+			case *quota.ListQuotasForbidden:
+				return nil, fmt.Errorf("forbidden to list quotas")
+			case *quota.ListQuotasInternalServerError:
+				return nil, fmt.Errorf("internal server error occurred while listing quotas")
+			default:
+				return nil, fmt.Errorf("unknown error occurred while listing quotas: %v", err)
+			}
+		}
+		quotas = append(quotas, response.Payload...)
+		if int64(len(response.Payload)) < pageSize {
+			break
+		}
+		page++
+	}
+
+	return quotas, nil
+}
+
+func GetQuota(quotaID int64) (*models.Quota, error) {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize client context")
+	}
+
+	response, err := client.Quota.GetQuota(ctx, &quota.GetQuotaParams{ID: quotaID})
+	if err != nil {
+		switch err.(type) {
+		case *quota.GetQuotaUnauthorized:
+			return nil, fmt.Errorf("unauthorized to get quota %d", quotaID)
+		case *quota.GetQuotaForbidden:
+			return nil, fmt.Errorf("forbidden to get quota %d", quotaID)
+		case *quota.GetQuotaNotFound:
+			return nil, fmt.Errorf("quota %d not found", quotaID)
+		case *quota.GetQuotaInternalServerError:
+			return nil, fmt.Errorf("internal server error occurred while getting quota %d", quotaID)
+		default:
+			return nil, fmt.Errorf("unknown error occurred while getting quota %d: %v", quotaID, err)
+		}
+	}
+
+	return response.Payload, nil
+}
@@ -171,10 +171,13 @@ func UpdateRegistry(updateView *models.Registry, projectID int64) error {
 		Description:    &updateView.Description,
 		URL:            &updateView.URL,
 		AccessKey:      &updateView.Credential.AccessKey,
-		AccessSecret:   &updateView.Credential.AccessSecret,
 		CredentialType: &updateView.Credential.Type,
 		Insecure:       &updateView.Insecure,
 	}
+	// An empty secret keeps the saved one.
+	if updateView.Credential.AccessSecret != "" {
+		registryUpdate.AccessSecret = &updateView.Credential.AccessSecret
+	}
 
 	_, err = client.Registry.UpdateRegistry(
 		ctx,
@@ -228,8 +231,37 @@ func GetRegistryProviders() ([]string, error) {
 	return response.Payload, nil
 }
 
+// ListRegistryProviderInfos returns the credential and endpoint patterns of
+// the registry providers that have them, keyed by provider type.
+func ListRegistryProviderInfos() (map[string]models.RegistryProviderInfo, error) {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize client context")
+	}
+
+	response, err := client.Registry.ListRegistryProviderInfos(ctx, &registry.ListRegistryProviderInfosParams{})
+	if err != nil {
+		switch err.(type) {
+		case *registry.ListRegistryProviderInfosForbidden:
+			return nil, fmt.Errorf("forbidden to list registry provider infos")
+		case *registry.ListRegistryProviderInfosUnauthorized:
+			return nil, fmt.Errorf("unauthorized to list registry provider infos")
+		case *registry.ListRegistryProviderInfosInternalServerError:
+			return nil, fmt.Errorf("internal server error occurred while listing registry provider infos")
+		default:
+			return nil, fmt.Errorf("unknown error occurred while listing registry provider infos: %v", err)
+		}
+	}
+
+	return response.Payload, nil
+}
+
 func GetRegistryIdByName(registryName string) (int64, error) {
-	var opts ListFlags
+	opts := ListFlags{
+		Page:     1,
+		PageSize: 100,
+		Q:        "name=" + registryName,
+	}
 
 	r, err := ListRegistries(opts)
 	if err != nil {
@@ -242,5 +274,35 @@ func GetRegistryIdByName(registryName string) (int64, error) {
 		}
 	}
 
-	return 0, err
+	return 0, fmt.Errorf("registry %s not found", registryName)
+}
+
+// PingRegistry checks that Harbor can reach a registry with the given
+// credentials. With an ID the saved registry is checked, with the other
+// fields overriding its settings.
+func PingRegistry(ping *models.RegistryPing) error {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return fmt.Errorf("failed to initialize client context")
+	}
+
+	_, err = client.Registry.PingRegistry(ctx, &registry.PingRegistryParams{Registry: ping})
+	if err != nil {
+		switch e := err.(type) {
+		case *registry.PingRegistryBadRequest:
+			return fmt.Errorf("registry is unreachable or the credentials are invalid: %s", errorMessages(e.Payload))
+		case *registry.PingRegistryNotFound:
+			return fmt.Errorf("registry not found")
+		case *registry.PingRegistryForbidden:
+			return fmt.Errorf("forbidden to ping registry")
+		case *registry.PingRegistryUnauthorized:
+			return fmt.Errorf("unauthorized to ping registry")
+		case *registry.PingRegistryInternalServerError:
+			return fmt.Errorf("internal server error occurred while pinging registry")
+		default:
+			return fmt.Errorf("unknown error occurred while pinging registry: %v", err)
+		}
+	}
+
+	return nil
 }
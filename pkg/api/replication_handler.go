@@ -1 +1,373 @@
 package api
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/client/replication"
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// ListReplicationPolicies lists the replication policies across all pages,
+// filtered by the query q such as "name=~sync".
+func ListReplicationPolicies(q string) ([]*models.ReplicationPolicy, error) {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize client context")
+	}
+
+	var policies []*models.ReplicationPolicy
+	page, pageSize := int64(1), int64(100)
+	for {
+		response, err := client.Replication.ListReplicationPolicies(ctx, &replication.ListReplicationPoliciesParams{
+			Page:     &page,
+			PageSize: &pageSize,
+			Q:        &q,
+		})
+		if err != nil {
+			switch err.(type) {
+			case *replication.ListReplicationPoliciesUnauthorized:
+				return nil, fmt.Errorf("unauthorized to list replication policies")
+			case *replication.ListReplicationPoliciesForbidden:
+				return nil, fmt.Errorf("forbidden to list replication policies")
+			case *replication.ListReplicationPoliciesInternalServerError:
+				return nil, fmt.Errorf("internal server error occurred while listing replication policies")
+			default:
+				return nil, fmt.Errorf("unknown error occurred while listing replication policies: %v", err)
+			}
+		}
+		policies = append(policies, response.Payload...)
+		if int64(len(response.Payload)) < pageSize {
+			break
+		}
+		page++
+	}
+
+	return policies, nil
+}
+
+func GetReplicationPolicy(id int64) (*models.ReplicationPolicy, error) {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize client context")
+	}
+
+	response, err := client.Replication.GetReplicationPolicy(ctx, &replication.GetReplicationPolicyParams{ID: id})
+	if err != nil {
+		switch err.(type) {
+		case *replication.GetReplicationPolicyUnauthorized:
+			return nil, fmt.Errorf("unauthorized to get replication policy %d", id)
+		case *replication.GetReplicationPolicyForbidden:
+			return nil, fmt.Errorf("forbidden to get replication policy %d", id)
+		case *replication.GetReplicationPolicyInternalServerError:
+			return nil, fmt.Errorf("internal server error occurred while getting replication policy %d", id)
+		default:
+			return nil, fmt.Errorf("unknown error occurred while getting replication policy %d: %v", id, err)
+		}
+	}
+
+	return response.Payload, nil
+}
+
+func CreateReplicationPolicy(policy *models.ReplicationPolicy) error {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return fmt.Errorf("failed to initialize client context")
+	}
+
+	_, err = client.Replication.CreateReplicationPolicy(ctx, &replication.CreateReplicationPolicyParams{Policy: policy})
+	if err != nil {
+		switch e := err.(type) {
+		case *replication.CreateReplicationPolicyBadRequest:
+			return fmt.Errorf("invalid replication policy %s: %s", policy.Name, errorMessages(e.Payload))
+		case *replication.CreateReplicationPolicyUnauthorized:
+			return fmt.Errorf("unauthorized to create replication policy %s", policy.Name)
+		case *replication.CreateReplicationPolicyForbidden:
+			return fmt.Errorf("forbidden to create replication policy %s", policy.Name)
+		case *replication.CreateReplicationPolicyConflict:
+			return fmt.Errorf("replication policy %s already exists", policy.Name)
+		case *replication.CreateReplicationPolicyInternalServerError:
+			return fmt.Errorf("internal server error occurred while creating replication policy %s", policy.Name)
+		default:
+			return fmt.Errorf("unknown error occurred while creating replication policy %s: %v", policy.Name, err)
+		}
+	}
+
+	log.Infof("replication policy %s created successfully", policy.Name)
+	return nil
+}
+
+func UpdateReplicationPolicy(policy *models.ReplicationPolicy) error {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return fmt.Errorf("failed to initialize client context")
+	}
+
+	_, err = client.Replication.UpdateReplicationPolicy(ctx, &replication.UpdateReplicationPolicyParams{
+		ID:     policy.ID,
+		Policy: policy,
+	})
+	if err != nil {
+		switch err.(type) {
+		case *replication.UpdateReplicationPolicyUnauthorized:
+			return fmt.Errorf("unauthorized to update replication policy %s", policy.Name)
+		case *replication.UpdateReplicationPolicyForbidden:
+			return fmt.Errorf("forbidden to update replication policy %s", policy.Name)
+		case *replication.UpdateReplicationPolicyNotFound:
+			return fmt.Errorf("replication policy %s not found", policy.Name)
+		case *replication.UpdateReplicationPolicyConflict:
+			return fmt.Errorf("replication policy %s already exists", policy.Name)
+		case *replication.UpdateReplicationPolicyInternalServerError:
+			return fmt.Errorf("internal server error occurred while updating replication policy %s", policy.Name)
+		default:
+			return fmt.Errorf("unknown error occurred while updating replication policy %s: %v", policy.Name, err)
+		}
+	}
+
+	log.Infof("replication policy %s updated successfully", policy.Name)
+	return nil
+}
+
+func DeleteReplicationPolicy(id int64) error {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return fmt.Errorf("failed to initialize client context")
+	}
+
+	_, err = client.Replication.DeleteReplicationPolicy(ctx, &replication.DeleteReplicationPolicyParams{ID: id})
+	if err != nil {
+		switch err.(type) {
+		case *replication.DeleteReplicationPolicyUnauthorized:
+			return fmt.Errorf("unauthorized to delete replication policy %d", id)
+		case *replication.DeleteReplicationPolicyForbidden:
+			return fmt.Errorf("forbidden to delete replication policy %d", id)
+		case *replication.DeleteReplicationPolicyNotFound:
+			return fmt.Errorf("replication policy %d not found", id)
+		case *replication.DeleteReplicationPolicyPreconditionFailed:
+			return fmt.Errorf("replication policy %d has running executions, stop them first", id)
+		case *replication.DeleteReplicationPolicyInternalServerError:
+			return fmt.Errorf("internal server error occurred while deleting replication policy %d", id)
+		default:
+			return fmt.Errorf("unknown error occurred while deleting replication policy %d: %v", id, err)
+		}
+	}
+
+	log.Infof("replication policy %d deleted successfully", id)
+	return nil
+}
+
+// StartReplication starts an execution of the policy and returns its ID.
+func StartReplication(policyID int64) (int64, error) {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return 0, fmt.Errorf("failed to initialize client context")
+	}
+
+	response, err := client.Replication.StartReplication(ctx, &replication.StartReplicationParams{
+		Execution: &models.StartReplicationExecution{PolicyID: policyID},
+	})
+	if err != nil {
+		switch e := err.(type) {
+		case *replication.StartReplicationBadRequest:
+			return 0, fmt.Errorf("cannot start replication policy %d: %s", policyID, errorMessages(e.Payload))
+		case *replication.StartReplicationUnauthorized:
+			return 0, fmt.Errorf("unauthorized to start replication policy %d", policyID)
+		case *replication.StartReplicationForbidden:
+			return 0, fmt.Errorf("forbidden to start replication policy %d", policyID)
+		case *replication.StartReplicationInternalServerError:
+			return 0, fmt.Errorf("internal server error occurred while starting replication policy %d", policyID)
+		default:
+			return 0, fmt.Errorf("unknown error occurred while starting replication policy %d: %v", policyID, err)
+		}
+	}
+
+	// The location of the new execution ends with its ID.
+	location := response.Location
+	executionID, err := strconv.ParseInt(location[strings.LastIndex(location, "/")+1:], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("unexpected location of the started execution: %q", location)
+	}
+	return executionID, nil
+}
+
+func StopReplication(executionID int64) error {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return fmt.Errorf("failed to initialize client context")
+	}
+
+	_, err = client.Replication.StopReplication(ctx, &replication.StopReplicationParams{ID: executionID})
+	if err != nil {
+		switch err.(type) {
+		case *replication.StopReplicationUnauthorized:
+			return fmt.Errorf("unauthorized to stop replication execution %d", executionID)
+		case *replication.StopReplicationForbidden:
+			return fmt.Errorf("forbidden to stop replication execution %d", executionID)
+		case *replication.StopReplicationNotFound:
+			return fmt.Errorf("replication execution %d not found", executionID)
+		case *replication.StopReplicationInternalServerError:
+			return fmt.Errorf("internal server error occurred while stopping replication execution %d", executionID)
+		default:
+			return fmt.Errorf("unknown error occurred while stopping replication execution %d: %v", executionID, err)
+		}
+	}
+
+	log.Infof("replication execution %d stopped", executionID)
+	return nil
+}
+
+// ListReplicationExecutions lists the executions of a policy, newest first,
+// optionally only those with the given status such as "InProgress".
+func ListReplicationExecutions(policyID int64, status string) ([]*models.ReplicationExecution, error) {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize client context")
+	}
+
+	var executions []*models.ReplicationExecution
+	sort := "-id"
+	page, pageSize := int64(1), int64(100)
+	for {
+		params := &replication.ListReplicationExecutionsParams{
+			PolicyID: &policyID,
+			Page:     &page,
+			PageSize: &pageSize,
+			Sort:     &sort,
+		}
+		if status != "" {
+			params.Status = &status
+		}
+		response, err := client.Replication.ListReplicationExecutions(ctx, params)
+		if err != nil {
+			switch err.(type) {
+			case *replication.ListReplicationExecutionsUnauthorized:
+				return nil, fmt.Errorf("unauthorized to list executions of replication policy %d", policyID)
+			case *replication.ListReplicationExecutionsForbidden:
+				return nil, fmt.Errorf("forbidden to list executions of replication policy %d", policyID)
+			case *replication.ListReplicationExecutionsInternalServerError:
+				return nil, fmt.Errorf("internal server error occurred while listing executions of replication policy %d", policyID)
+			default:
+				return nil, fmt.Errorf("unknown error occurred while listing executions of replication policy %d: %v", policyID, err)
+			}
+		}
+		executions = append(executions, response.Payload...)
+		if int64(len(response.Payload)) < pageSize {
+			break
+		}
+		page++
+	}
+
+	return executions, nil
+}
+
+func GetReplicationExecution(executionID int64) (*models.ReplicationExecution, error) {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize client context")
+	}
+
+	response, err := client.Replication.GetReplicationExecution(ctx, &replication.GetReplicationExecutionParams{ID: executionID})
+	if err != nil {
+		switch err.(type) {
+		case *replication.GetReplicationExecutionUnauthorized:
+			return nil, fmt.Errorf("unauthorized to get replication execution %d", executionID)
+		case *replication.GetReplicationExecutionForbidden:
+			return nil, fmt.Errorf("forbidden to get replication execution %d", executionID)
+		case *replication.GetReplicationExecutionNotFound:
+			return nil, fmt.Errorf("replication execution %d not found", executionID)
+		case *replication.GetReplicationExecutionInternalServerError:
+			return nil, fmt.Errorf("internal server error occurred while getting replication execution %d", executionID)
+		default:
+			return nil, fmt.Errorf("unknown error occurred while getting replication execution %d: %v", executionID, err)
+		}
+	}
+
+	return response.Payload, nil
+}
+
+// ListReplicationTasks lists the tasks of an execution, one per replicated
+// resource, optionally only those with the given status such as "Failed".
+func ListReplicationTasks(executionID int64, status string) ([]*models.ReplicationTask, error) {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize client context")
+	}
+
+	var tasks []*models.ReplicationTask
+	sort := "id"
+	page, pageSize := int64(1), int64(100)
+	for {
+		params := &replication.ListReplicationTasksParams{
+			ID:       executionID,
+			Page:     &page,
+			PageSize: &pageSize,
+			Sort:     &sort,
+		}
+		if status != "" {
+			params.Status = &status
+		}
+		response, err := client.Replication.ListReplicationTasks(ctx, params)
+		if err != nil {
+			switch err.(type) {
+			case *replication.ListReplicationTasksUnauthorized:
+				return nil, fmt.Errorf("unauthorized to list tasks of replication execution %d", executionID)
+			case *replication.ListReplicationTasksForbidden:
+				return nil, fmt.Errorf("forbidden to list tasks of replication execution %d", executionID)
+			case *replication.ListReplicationTasksInternalServerError:
+				return nil, fmt.Errorf("internal server error occurred while listing tasks of replication execution %d", executionID)
+			default:
+				return nil, fmt.Errorf("unknown error occurred while listing tasks of replication execution %d: %v", executionID, err)
+			}
+		}
+		tasks = append(tasks, response.Payload...)
+		if int64(len(response.Payload)) < pageSize {
+			break
+		}
+		page++
+	}
+
+	return tasks, nil
+}
+
+func GetReplicationTaskLog(executionID, taskID int64) (string, error) {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return "", fmt.Errorf("failed to initialize client context")
+	}
+
+	response, err := client.Replication.GetReplicationLog(ctx, &replication.GetReplicationLogParams{
+		ID:     executionID,
+		TaskID: taskID,
+	})
+	if err != nil {
+		switch err.(type) {
+		case *replication.GetReplicationLogUnauthorized:
+			return "", fmt.Errorf("unauthorized to get log of replication task %d", taskID)
+		case *replication.GetReplicationLogForbidden:
+			return "", fmt.Errorf("forbidden to get log of replication task %d", taskID)
+		case *replication.GetReplicationLogNotFound:
+			return "", fmt.Errorf("replication task %d of execution %d not found", taskID, executionID)
+		case *replication.GetReplicationLogInternalServerError:
+			return "", fmt.Errorf("internal server error occurred while getting log of replication task %d", taskID)
+		default:
+			return "", fmt.Errorf("unknown error occurred while getting log of replication task %d: %v", taskID, err)
+		}
+	}
+
+	return response.Payload, nil
+}
+
+// errorMessages joins the messages of an error response of Harbor.
+func errorMessages(payload *models.Errors) string {
+	if payload == nil {
+		return ""
+	}
+	var messages []string
+	for _, e := range payload.Errors {
+		messages = append(messages, e.Message)
+	}
+	return strings.Join(messages, "; ")
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/goharbor/go-client/pkg/sdk/v2.0/client/repository"
 	"github.com/goharbor/go-client/pkg/sdk/v2.0/client/search"
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
 	"github.com/goharbor/harbor-cli/pkg/utils"
 	log "github.com/sirupsen/logrus"
 )
@@ -97,6 +98,46 @@ func ListRepository(projectName string) (repository.ListRepositoriesOK, error) {
 	log.Infof("Repositories for project %s listed successfully", projectName)
 	return *response, nil
 }
+
+// ListAllRepositories lists the repositories of a project across all pages.
+func ListAllRepositories(projectName string) ([]*models.Repository, error) {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize client context")
+	}
+
+	var repositories []*models.Repository
+	page, pageSize := int64(1), int64(100)
+	for {
+		response, err := client.Repository.ListRepositories(ctx, &repository.ListRepositoriesParams{
+			ProjectName: projectName,
+			Page:        &page,
+			PageSize:    &pageSize,
+		})
+		if err != nil {
+			switch err.(type) {
+			case *repository.ListRepositoriesNotFound:
+				return nil, fmt.Errorf("project not found: %s", projectName)
+			case *repository.ListRepositoriesBadRequest:
+				return nil, fmt.Errorf("bad request while listing repositories: %s", projectName)
+			case *repository.ListRepositoriesForbidden:
+				return nil, fmt.Errorf("forbidden to list repositories: %s", projectName)
+			case *repository.ListRepositoriesInternalServerError:
+				return nil, fmt.Errorf("internal server error occurred while listing repositories: %s", projectName)
+			default:
+				return nil, fmt.Errorf("unknown error occurred while listing repositories: %v", err)
+			}
+		}
+		repositories = append(repositories, response.Payload...)
+		if int64(len(response.Payload)) < pageSize {
+			break
+		}
+		page++
+	}
+
+	return repositories, nil
+}
+
 func SearchRepository(query string) (search.SearchOK, error) {
 	ctx, client, err := utils.ContextWithClient()
 	if err != nil {
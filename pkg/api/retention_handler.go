@@ -0,0 +1,213 @@
+package api
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/client/retention"
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// GetProjectRetentionID returns the ID of a project and of its retention
+// policy, which is 0 when the project has none.
+func GetProjectRetentionID(projectName string) (int64, int64, error) {
+	response, err := GetProject(projectName)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	project := response.Payload
+	projectID := int64(project.ProjectID)
+	if project.Metadata == nil || project.Metadata.RetentionID == nil || *project.Metadata.RetentionID == "" {
+		return projectID, 0, nil
+	}
+	retentionID, err := strconv.ParseInt(*project.Metadata.RetentionID, 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid retention policy ID %q of project %s", *project.Metadata.RetentionID, projectName)
+	}
+	return projectID, retentionID, nil
+}
+
+func GetRetention(retentionID int64) (*models.RetentionPolicy, error) {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize client context")
+	}
+
+	response, err := client.Retention.GetRetention(ctx, &retention.GetRetentionParams{ID: retentionID})
+	if err != nil {
+		switch err.(type) {
+		case *retention.GetRetentionUnauthorized:
+			return nil, fmt.Errorf("unauthorized to get retention policy %d", retentionID)
+		case *retention.GetRetentionForbidden:
+			return nil, fmt.Errorf("forbidden to get retention policy %d", retentionID)
+		case *retention.GetRetentionInternalServerError:
+			return nil, fmt.Errorf("internal server error occurred while getting retention policy %d", retentionID)
+		default:
+			return nil, fmt.Errorf("unknown error occurred while getting retention policy %d: %v", retentionID, err)
+		}
+	}
+
+	return response.Payload, nil
+}
+
+func CreateRetention(policy *models.RetentionPolicy) error {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return fmt.Errorf("failed to initialize client context")
+	}
+
+	_, err = client.Retention.CreateRetention(ctx, &retention.CreateRetentionParams{Policy: policy})
+	if err != nil {
+		switch err.(type) {
+		case *retention.CreateRetentionBadRequest:
+			return fmt.Errorf("invalid retention policy")
+		case *retention.CreateRetentionUnauthorized:
+			return fmt.Errorf("unauthorized to create retention policy")
+		case *retention.CreateRetentionForbidden:
+			return fmt.Errorf("forbidden to create retention policy")
+		case *retention.CreateRetentionInternalServerError:
+			return fmt.Errorf("internal server error occurred while creating retention policy")
+		default:
+			return fmt.Errorf("unknown error occurred while creating retention policy: %v", err)
+		}
+	}
+
+	log.Infof("retention policy created successfully")
+	return nil
+}
+
+func UpdateRetention(retentionID int64, policy *models.RetentionPolicy) error {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return fmt.Errorf("failed to initialize client context")
+	}
+
+	_, err = client.Retention.UpdateRetention(ctx, &retention.UpdateRetentionParams{ID: retentionID, Policy: policy})
+	if err != nil {
+		switch err.(type) {
+		case *retention.UpdateRetentionUnauthorized:
+			return fmt.Errorf("unauthorized to update retention policy %d", retentionID)
+		case *retention.UpdateRetentionForbidden:
+			return fmt.Errorf("forbidden to update retention policy %d", retentionID)
+		case *retention.UpdateRetentionInternalServerError:
+			return fmt.Errorf("internal server error occurred while updating retention policy %d", retentionID)
+		default:
+			return fmt.Errorf("unknown error occurred while updating retention policy %d: %v", retentionID, err)
+		}
+	}
+
+	log.Infof("retention policy updated successfully")
+	return nil
+}
+
+// TriggerRetention starts an execution of the retention policy. A dry run
+// only reports what would be deleted.
+func TriggerRetention(retentionID int64, dryRun bool) error {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return fmt.Errorf("failed to initialize client context")
+	}
+
+	_, _, err = client.Retention.TriggerRetentionExecution(ctx, &retention.TriggerRetentionExecutionParams{
+		ID:   retentionID,
+		Body: retention.TriggerRetentionExecutionBody{DryRun: dryRun},
+	})
+	if err != nil {
+		switch err.(type) {
+		case *retention.TriggerRetentionExecutionUnauthorized:
+			return fmt.Errorf("unauthorized to run retention policy %d", retentionID)
+		case *retention.TriggerRetentionExecutionForbidden:
+			return fmt.Errorf("forbidden to run retention policy %d", retentionID)
+		case *retention.TriggerRetentionExecutionInternalServerError:
+			return fmt.Errorf("internal server error occurred while running retention policy %d", retentionID)
+		default:
+			return fmt.Errorf("unknown error occurred while running retention policy %d: %v", retentionID, err)
+		}
+	}
+
+	if dryRun {
+		log.Infof("retention dry run started successfully")
+	} else {
+		log.Infof("retention run started successfully")
+	}
+	return nil
+}
+
+// ListRetentionExecutions lists the executions of a retention policy,
+// latest first.
+func ListRetentionExecutions(retentionID int64) ([]*models.RetentionExecution, error) {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize client context")
+	}
+
+	var executions []*models.RetentionExecution
+	page, pageSize := int64(1), int64(100)
+	for {
+		response, err := client.Retention.ListRetentionExecutions(ctx, &retention.ListRetentionExecutionsParams{
+			ID:       retentionID,
+			Page:     &page,
+			PageSize: &pageSize,
+		})
+		if err != nil {
+			switch err.(type) {
+			case *retention.ListRetentionExecutionsUnauthorized:
+				return nil, fmt.Errorf("unauthorized to list retention executions")
+			case *retention.ListRetentionExecutionsForbidden:
+				return nil, fmt.Errorf("forbidden to list retention executions")
+			case *retention.ListRetentionExecutionsInternalServerError:
+				return nil, fmt.Errorf("internal server error occurred while listing retention executions")
+			default:
+				return nil, fmt.Errorf("unknown error occurred while listing retention executions: %v", err)
+			}
+		}
+		executions = append(executions, response.Payload...)
+		if int64(len(response.Payload)) < pageSize {
+			break
+		}
+		page++
+	}
+
+	return executions, nil
+}
+
+// ListRetentionTasks lists the per repository tasks of a retention execution.
+func ListRetentionTasks(retentionID, executionID int64) ([]*models.RetentionExecutionTask, error) {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize client context")
+	}
+
+	var tasks []*models.RetentionExecutionTask
+	page, pageSize := int64(1), int64(100)
+	for {
+		response, err := client.Retention.ListRetentionTasks(ctx, &retention.ListRetentionTasksParams{
+			ID:       retentionID,
+			Eid:      executionID,
+			Page:     &page,
+			PageSize: &pageSize,
+		})
+		if err != nil {
+			switch err.(type) {
+			case *retention.ListRetentionTasksUnauthorized:
+				return nil, fmt.Errorf("unauthorized to list tasks of retention execution %d", executionID)
+			case *retention.ListRetentionTasksForbidden:
+				return nil, fmt.Errorf("forbidden to list tasks of retention execution %d", executionID)
+			case *retention.ListRetentionTasksInternalServerError:
+				return nil, fmt.Errorf("internal server error occurred while listing tasks of retention execution %d", executionID)
+			default:
+				return nil, fmt.Errorf("unknown error occurred while listing tasks of retention execution %d: %v", executionID, err)
+			}
+		}
+		tasks = append(tasks, response.Payload...)
+		if int64(len(response.Payload)) < pageSize {
+			break
+		}
+		page++
+	}
+
+	return tasks, nil
+}
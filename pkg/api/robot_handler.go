@@ -0,0 +1,212 @@
+package api
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/client/robot"
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// ListRobots lists robot accounts across all pages. The query selects the
+// level and project, e.g. "Level=project,ProjectID=1".
+func ListRobots(q string) ([]*models.Robot, error) {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize client context")
+	}
+
+	var robots []*models.Robot
+	page, pageSize := int64(1), int64(100)
+	for {
+		response, err := client.Robot.ListRobot(ctx, &robot.ListRobotParams{
+			Page:     &page,
+			PageSize: &pageSize,
+			Q:        &q,
+		})
+		if err != nil {
+			switch err.(type) {
+			case *robot.ListRobotBadRequest:
+				return nil, fmt.Errorf("bad request while listing robot accounts: %s", q)
+			case *robot.ListRobotNotFound:
+				return nil, fmt.Errorf("no robot accounts found: %s", q)
+			case *robot.ListRobotInternalServerError:
+				return nil, fmt.Errorf("internal server error occurred while listing robot accounts")
+			default:
+				return nil, fmt.Errorf("unknown error occurred while listing robot accounts: %v", err)
+			}
+		}
+		robots = append(robots, response.Payload...)
+		if int64(len(response.Payload)) < pageSize {
+			break
+		}
+		page++
+	}
+
+	return robots, nil
+}
+
+func GetRobot(robotID int64) (*models.Robot, error) {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize client context")
+	}
+
+	response, err := client.Robot.GetRobotByID(ctx, &robot.GetRobotByIDParams{RobotID: robotID})
+	if err != nil {
+		switch err.(type) {
+		case *robot.GetRobotByIDNotFound:
+			return nil, fmt.Errorf("robot account not found: %d", robotID)
+		case *robot.GetRobotByIDForbidden:
+			return nil, fmt.Errorf("forbidden to view robot account: %d", robotID)
+		case *robot.GetRobotByIDUnauthorized:
+			return nil, fmt.Errorf("unauthorized access to view robot account: %d", robotID)
+		case *robot.GetRobotByIDInternalServerError:
+			return nil, fmt.Errorf("internal server error occurred while viewing robot account: %d", robotID)
+		default:
+			return nil, fmt.Errorf("unknown error occurred while viewing robot account: %v", err)
+		}
+	}
+
+	return response.Payload, nil
+}
+
+// GetRobotIdByName resolves a robot account given by ID or by name, with
+// or without the robot$ prefix.
+func GetRobotIdByName(name string) (int64, error) {
+	if id, err := strconv.ParseInt(name, 10, 64); err == nil {
+		return id, nil
+	}
+
+	robots, err := ListRobots("")
+	if err != nil {
+		return 0, err
+	}
+	for _, r := range robots {
+		if r.Name == name || strings.TrimPrefix(r.Name, "robot$") == name {
+			return r.ID, nil
+		}
+	}
+
+	return 0, fmt.Errorf("robot account not found: %s", name)
+}
+
+// CreateRobot creates a robot account and returns it with its secret.
+func CreateRobot(opts *models.RobotCreate) (*models.RobotCreated, error) {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize client context")
+	}
+
+	response, err := client.Robot.CreateRobot(ctx, &robot.CreateRobotParams{Robot: opts})
+	if err != nil {
+		switch err.(type) {
+		case *robot.CreateRobotBadRequest:
+			return nil, fmt.Errorf("bad request while creating robot account: %s", opts.Name)
+		case *robot.CreateRobotNotFound:
+			return nil, fmt.Errorf("project of robot account %s not found", opts.Name)
+		case *robot.CreateRobotForbidden:
+			return nil, fmt.Errorf("forbidden to create robot account: %s", opts.Name)
+		case *robot.CreateRobotUnauthorized:
+			return nil, fmt.Errorf("unauthorized access to create robot account")
+		case *robot.CreateRobotInternalServerError:
+			return nil, fmt.Errorf("internal server error occurred while creating robot account")
+		default:
+			return nil, fmt.Errorf("unknown error occurred while creating robot account: %v", err)
+		}
+	}
+
+	log.Infof("Robot account %s created", response.Payload.Name)
+	return response.Payload, nil
+}
+
+func UpdateRobot(r *models.Robot) error {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return fmt.Errorf("failed to initialize client context")
+	}
+
+	_, err = client.Robot.UpdateRobot(ctx, &robot.UpdateRobotParams{RobotID: r.ID, Robot: r})
+	if err != nil {
+		switch err.(type) {
+		case *robot.UpdateRobotBadRequest:
+			return fmt.Errorf("bad request while updating robot account: %s", r.Name)
+		case *robot.UpdateRobotNotFound:
+			return fmt.Errorf("robot account not found: %s", r.Name)
+		case *robot.UpdateRobotConflict:
+			return fmt.Errorf("conflict while updating robot account: %s", r.Name)
+		case *robot.UpdateRobotForbidden:
+			return fmt.Errorf("forbidden to update robot account: %s", r.Name)
+		case *robot.UpdateRobotUnauthorized:
+			return fmt.Errorf("unauthorized access to update robot account")
+		case *robot.UpdateRobotInternalServerError:
+			return fmt.Errorf("internal server error occurred while updating robot account")
+		default:
+			return fmt.Errorf("unknown error occurred while updating robot account: %v", err)
+		}
+	}
+
+	log.Infof("Robot account %s updated", r.Name)
+	return nil
+}
+
+func DeleteRobot(robotID int64) error {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return fmt.Errorf("failed to initialize client context")
+	}
+
+	_, err = client.Robot.DeleteRobot(ctx, &robot.DeleteRobotParams{RobotID: robotID})
+	if err != nil {
+		switch err.(type) {
+		case *robot.DeleteRobotBadRequest:
+			return fmt.Errorf("bad request while deleting robot account: %d", robotID)
+		case *robot.DeleteRobotNotFound:
+			return fmt.Errorf("robot account not found: %d", robotID)
+		case *robot.DeleteRobotForbidden:
+			return fmt.Errorf("forbidden to delete robot account: %d", robotID)
+		case *robot.DeleteRobotUnauthorized:
+			return fmt.Errorf("unauthorized access to delete robot account: %d", robotID)
+		case *robot.DeleteRobotInternalServerError:
+			return fmt.Errorf("internal server error occurred while deleting robot account: %d", robotID)
+		default:
+			return fmt.Errorf("unknown error occurred while deleting robot account: %v", err)
+		}
+	}
+
+	log.Info("robot account deleted successfully")
+	return nil
+}
+
+// RefreshRobotSecret replaces the secret of a robot account with a
+// generated one and returns it.
+func RefreshRobotSecret(robotID int64) (string, error) {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return "", fmt.Errorf("failed to initialize client context")
+	}
+
+	response, err := client.Robot.RefreshSec(ctx, &robot.RefreshSecParams{RobotID: robotID, RobotSec: &models.RobotSec{}})
+	if err != nil {
+		switch err.(type) {
+		case *robot.RefreshSecBadRequest:
+			return "", fmt.Errorf("bad request while refreshing secret of robot account: %d", robotID)
+		case *robot.RefreshSecNotFound:
+			return "", fmt.Errorf("robot account not found: %d", robotID)
+		case *robot.RefreshSecForbidden:
+			return "", fmt.Errorf("forbidden to refresh secret of robot account: %d", robotID)
+		case *robot.RefreshSecUnauthorized:
+			return "", fmt.Errorf("unauthorized access to refresh secret of robot account: %d", robotID)
+		case *robot.RefreshSecInternalServerError:
+			return "", fmt.Errorf("internal server error occurred while refreshing secret of robot account: %d", robotID)
+		default:
+			return "", fmt.Errorf("unknown error occurred while refreshing secret of robot account: %v", err)
+		}
+	}
+
+	log.Info("robot account secret refreshed successfully")
+	return response.Payload.Secret, nil
+}
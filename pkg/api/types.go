@@ -9,6 +9,11 @@ type ListFlags struct {
 	Q         string
 	Sort      string
 	Public    bool
+	WithLabel bool
+	// WithAccessory includes signatures, SBOMs and other accessories
+	WithAccessory    bool
+	WithTag          bool
+	WithScanOverview bool
 }
 
 // CreateView for Registry
@@ -27,3 +32,21 @@ type RegistryCredential struct {
 	Type         string `json:"type,omitempty"`
 	AccessSecret string `json:"access_secret,omitempty"`
 }
+
+// VulnerabilityReport is the vulnerabilities addition of a scanned artifact
+type VulnerabilityReport struct {
+	GeneratedAt     string          `json:"generated_at"`
+	Severity        string          `json:"severity"`
+	Vulnerabilities []Vulnerability `json:"vulnerabilities"`
+}
+
+// Vulnerability found in a package of an artifact
+type Vulnerability struct {
+	ID          string   `json:"id"`
+	Package     string   `json:"package"`
+	Version     string   `json:"version"`
+	FixVersion  string   `json:"fix_version,omitempty"`
+	Severity    string   `json:"severity"`
+	Description string   `json:"description,omitempty"`
+	Links       []string `json:"links,omitempty"`
+}
@@ -133,7 +133,7 @@ func ListUsers(opts ...ListFlags) (*user.ListUsersOK, error) {
 }
 
 func GetUsersIdByName(userName string) (int64, error) {
-	opts := ListFlags{}
+	opts := ListFlags{Page: 1, PageSize: 100, Q: "username=" + userName}
 
 	u, err := ListUsers(opts)
 	if err != nil {
@@ -0,0 +1,294 @@
+package api
+
+import (
+	"fmt"
+
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/client/webhook"
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// ListWebhooks lists the webhook policies of a project.
+func ListWebhooks(projectName string) ([]*models.WebhookPolicy, error) {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize client context")
+	}
+
+	var policies []*models.WebhookPolicy
+	page, pageSize := int64(1), int64(100)
+	for {
+		response, err := client.Webhook.ListWebhookPoliciesOfProject(ctx, &webhook.ListWebhookPoliciesOfProjectParams{
+			ProjectNameOrID: projectName,
+			Page:            &page,
+			PageSize:        &pageSize,
+		})
+		if err != nil {
+			switch err.(type) {
+			case *webhook.ListWebhookPoliciesOfProjectBadRequest:
+				return nil, fmt.Errorf("bad request while listing webhooks of project %s", projectName)
+			case *webhook.ListWebhookPoliciesOfProjectUnauthorized:
+				return nil, fmt.Errorf("unauthorized to list webhooks of project %s", projectName)
+			case *webhook.ListWebhookPoliciesOfProjectForbidden:
+				return nil, fmt.Errorf("forbidden to list webhooks of project %s", projectName)
+			case *webhook.ListWebhookPoliciesOfProjectInternalServerError:
+				return nil, fmt.Errorf("internal server error occurred while listing webhooks of project %s", projectName)
+			default:
+				return nil, fmt.Errorf("unknown error occurred while listing webhooks of project %s: %v", projectName, err)
+			}
+		}
+		policies = append(policies, response.Payload...)
+		if int64(len(response.Payload)) < pageSize {
+			break
+		}
+		page++
+	}
+
+	return policies, nil
+}
+
+func CreateWebhook(projectName string, policy *models.WebhookPolicy) error {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return fmt.Errorf("failed to initialize client context")
+	}
+
+	_, err = client.Webhook.CreateWebhookPolicyOfProject(ctx, &webhook.CreateWebhookPolicyOfProjectParams{
+		ProjectNameOrID: projectName,
+		Policy:          policy,
+	})
+	if err != nil {
+		switch err.(type) {
+		case *webhook.CreateWebhookPolicyOfProjectBadRequest:
+			return fmt.Errorf("invalid webhook %s", policy.Name)
+		case *webhook.CreateWebhookPolicyOfProjectUnauthorized:
+			return fmt.Errorf("unauthorized to create webhook in project %s", projectName)
+		case *webhook.CreateWebhookPolicyOfProjectForbidden:
+			return fmt.Errorf("forbidden to create webhook in project %s", projectName)
+		case *webhook.CreateWebhookPolicyOfProjectInternalServerError:
+			return fmt.Errorf("internal server error occurred while creating webhook %s", policy.Name)
+		default:
+			return fmt.Errorf("unknown error occurred while creating webhook %s: %v", policy.Name, err)
+		}
+	}
+
+	log.Infof("webhook %s created successfully", policy.Name)
+	return nil
+}
+
+func UpdateWebhook(projectName string, policy *models.WebhookPolicy) error {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return fmt.Errorf("failed to initialize client context")
+	}
+
+	_, err = client.Webhook.UpdateWebhookPolicyOfProject(ctx, &webhook.UpdateWebhookPolicyOfProjectParams{
+		ProjectNameOrID: projectName,
+		WebhookPolicyID: policy.ID,
+		Policy:          policy,
+	})
+	if err != nil {
+		switch err.(type) {
+		case *webhook.UpdateWebhookPolicyOfProjectBadRequest:
+			return fmt.Errorf("invalid webhook %s", policy.Name)
+		case *webhook.UpdateWebhookPolicyOfProjectUnauthorized:
+			return fmt.Errorf("unauthorized to update webhook %s", policy.Name)
+		case *webhook.UpdateWebhookPolicyOfProjectForbidden:
+			return fmt.Errorf("forbidden to update webhook %s", policy.Name)
+		case *webhook.UpdateWebhookPolicyOfProjectNotFound:
+			return fmt.Errorf("webhook %s not found", policy.Name)
+		case *webhook.UpdateWebhookPolicyOfProjectInternalServerError:
+			return fmt.Errorf("internal server error occurred while updating webhook %s", policy.Name)
+		default:
+			return fmt.Errorf("unknown error occurred while updating webhook %s: %v", policy.Name, err)
+		}
+	}
+
+	log.Infof("webhook %s updated successfully", policy.Name)
+	return nil
+}
+
+func DeleteWebhook(projectName string, policyID int64) error {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return fmt.Errorf("failed to initialize client context")
+	}
+
+	_, err = client.Webhook.DeleteWebhookPolicyOfProject(ctx, &webhook.DeleteWebhookPolicyOfProjectParams{
+		ProjectNameOrID: projectName,
+		WebhookPolicyID: policyID,
+	})
+	if err != nil {
+		switch err.(type) {
+		case *webhook.DeleteWebhookPolicyOfProjectBadRequest:
+			return fmt.Errorf("bad request while deleting webhook %d", policyID)
+		case *webhook.DeleteWebhookPolicyOfProjectUnauthorized:
+			return fmt.Errorf("unauthorized to delete webhook %d", policyID)
+		case *webhook.DeleteWebhookPolicyOfProjectForbidden:
+			return fmt.Errorf("forbidden to delete webhook %d", policyID)
+		case *webhook.DeleteWebhookPolicyOfProjectNotFound:
+			return fmt.Errorf("webhook %d not found", policyID)
+		case *webhook.DeleteWebhookPolicyOfProjectInternalServerError:
+			return fmt.Errorf("internal server error occurred while deleting webhook %d", policyID)
+		default:
+			return fmt.Errorf("unknown error occurred while deleting webhook %d: %v", policyID, err)
+		}
+	}
+
+	log.Infof("webhook %d deleted successfully", policyID)
+	return nil
+}
+
+// GetWebhookEventTypes returns the event types webhooks of a project can
+// subscribe to.
+func GetWebhookEventTypes(projectName string) ([]string, error) {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize client context")
+	}
+
+	response, err := client.Webhook.GetSupportedEventTypes(ctx, &webhook.GetSupportedEventTypesParams{
+		ProjectNameOrID: projectName,
+	})
+	if err != nil {
+		switch err.(type) {
+		case *webhook.GetSupportedEventTypesUnauthorized:
+			return nil, fmt.Errorf("unauthorized to get webhook event types")
+		case *webhook.GetSupportedEventTypesForbidden:
+			return nil, fmt.Errorf("forbidden to get webhook event types")
+		case *webhook.GetSupportedEventTypesInternalServerError:
+			return nil, fmt.Errorf("internal server error occurred while getting webhook event types")
+		default:
+			return nil, fmt.Errorf("unknown error occurred while getting webhook event types: %v", err)
+		}
+	}
+
+	var eventTypes []string
+	for _, eventType := range response.Payload.EventType {
+		eventTypes = append(eventTypes, string(eventType))
+	}
+	return eventTypes, nil
+}
+
+// ListWebhookExecutions lists the deliveries of a webhook policy, latest
+// first, filtered by the query q such as "status=Error".
+func ListWebhookExecutions(projectName string, policyID int64, q string) ([]*models.Execution, error) {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize client context")
+	}
+
+	var executions []*models.Execution
+	sort := "-id"
+	page, pageSize := int64(1), int64(100)
+	for {
+		response, err := client.Webhook.ListExecutionsOfWebhookPolicy(ctx, &webhook.ListExecutionsOfWebhookPolicyParams{
+			ProjectNameOrID: projectName,
+			WebhookPolicyID: policyID,
+			Page:            &page,
+			PageSize:        &pageSize,
+			Q:               &q,
+			Sort:            &sort,
+		})
+		if err != nil {
+			switch err.(type) {
+			case *webhook.ListExecutionsOfWebhookPolicyBadRequest:
+				return nil, fmt.Errorf("bad request while listing executions of webhook %d: %s", policyID, q)
+			case *webhook.ListExecutionsOfWebhookPolicyUnauthorized:
+				return nil, fmt.Errorf("unauthorized to list executions of webhook %d", policyID)
+			case *webhook.ListExecutionsOfWebhookPolicyForbidden:
+				return nil, fmt.Errorf("forbidden to list executions of webhook %d", policyID)
+			case *webhook.ListExecutionsOfWebhookPolicyNotFound:
+				return nil, fmt.Errorf("webhook %d not found", policyID)
+			case *webhook.ListExecutionsOfWebhookPolicyInternalServerError:
+				return nil, fmt.Errorf("internal server error occurred while listing executions of webhook %d", policyID)
+			default:
+				return nil, fmt.Errorf("unknown error occurred while listing executions of webhook %d: %v", policyID, err)
+			}
+		}
+		executions = append(executions, response.Payload...)
+		if int64(len(response.Payload)) < pageSize {
+			break
+		}
+		page++
+	}
+
+	return executions, nil
+}
+
+// ListWebhookTasks lists the delivery attempts of a webhook execution.
+func ListWebhookTasks(projectName string, policyID, executionID int64) ([]*models.Task, error) {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize client context")
+	}
+
+	var tasks []*models.Task
+	page, pageSize := int64(1), int64(100)
+	for {
+		response, err := client.Webhook.ListTasksOfWebhookExecution(ctx, &webhook.ListTasksOfWebhookExecutionParams{
+			ProjectNameOrID: projectName,
+			WebhookPolicyID: policyID,
+			ExecutionID:     executionID,
+			Page:            &page,
+			PageSize:        &pageSize,
+		})
+		if err != nil {
+			switch err.(type) {
+			case *webhook.ListTasksOfWebhookExecutionBadRequest:
+				return nil, fmt.Errorf("bad request while listing tasks of webhook execution %d", executionID)
+			case *webhook.ListTasksOfWebhookExecutionUnauthorized:
+				return nil, fmt.Errorf("unauthorized to list tasks of webhook execution %d", executionID)
+			case *webhook.ListTasksOfWebhookExecutionForbidden:
+				return nil, fmt.Errorf("forbidden to list tasks of webhook execution %d", executionID)
+			case *webhook.ListTasksOfWebhookExecutionNotFound:
+				return nil, fmt.Errorf("webhook execution %d not found", executionID)
+			case *webhook.ListTasksOfWebhookExecutionInternalServerError:
+				return nil, fmt.Errorf("internal server error occurred while listing tasks of webhook execution %d", executionID)
+			default:
+				return nil, fmt.Errorf("unknown error occurred while listing tasks of webhook execution %d: %v", executionID, err)
+			}
+		}
+		tasks = append(tasks, response.Payload...)
+		if int64(len(response.Payload)) < pageSize {
+			break
+		}
+		page++
+	}
+
+	return tasks, nil
+}
+
+// GetWebhookTaskLog returns the log of a delivery attempt.
+func GetWebhookTaskLog(projectName string, policyID, executionID, taskID int64) (string, error) {
+	ctx, client, err := utils.ContextWithClient()
+	if err != nil {
+		return "", fmt.Errorf("failed to initialize client context")
+	}
+
+	response, err := client.Webhook.GetLogsOfWebhookTask(ctx, &webhook.GetLogsOfWebhookTaskParams{
+		ProjectNameOrID: projectName,
+		WebhookPolicyID: policyID,
+		ExecutionID:     executionID,
+		TaskID:          taskID,
+	})
+	if err != nil {
+		switch err.(type) {
+		case *webhook.GetLogsOfWebhookTaskBadRequest:
+			return "", fmt.Errorf("bad request while getting log of webhook task %d", taskID)
+		case *webhook.GetLogsOfWebhookTaskUnauthorized:
+			return "", fmt.Errorf("unauthorized to get log of webhook task %d", taskID)
+		case *webhook.GetLogsOfWebhookTaskForbidden:
+			return "", fmt.Errorf("forbidden to get log of webhook task %d", taskID)
+		case *webhook.GetLogsOfWebhookTaskNotFound:
+			return "", fmt.Errorf("webhook task %d not found", taskID)
+		case *webhook.GetLogsOfWebhookTaskInternalServerError:
+			return "", fmt.Errorf("internal server error occurred while getting log of webhook task %d", taskID)
+		default:
+			return "", fmt.Errorf("unknown error occurred while getting log of webhook task %d: %v", taskID, err)
+		}
+	}
+
+	return response.Payload, nil
+}
@@ -0,0 +1,501 @@
+package oci
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/goharbor/harbor-cli/pkg/utils"
+)
+
+// Client talks to the OCI distribution API exposed by Harbor under /v2/.
+// It authenticates with basic credentials and exchanges them for bearer
+// tokens when the registry asks for it.
+type Client struct {
+	baseURL    string
+	username   string
+	password   string
+	httpClient *http.Client
+
+	mu    sync.Mutex
+	token string
+}
+
+// NewClient returns a client for the registry at serverAddress.
+func NewClient(serverAddress, username, password string) *Client {
+	return &Client{
+		baseURL:    strings.TrimSuffix(utils.FormatUrl(serverAddress), "/"),
+		username:   username,
+		password:   password,
+		httpClient: http.DefaultClient,
+	}
+}
+
+// NewClientFromCurrentCredential returns a client using the credential of
+// the active context.
+func NewClientFromCurrentCredential() (*Client, error) {
+	credential, err := utils.GetCurrentCredential()
+	if err != nil {
+		return nil, err
+	}
+	return NewClient(credential.ServerAddress, credential.Username, credential.Password), nil
+}
+
+// Do sends the request, authenticating and retrying once when the
+// registry answers with an authentication challenge.
+func (c *Client) Do(req *http.Request) (*http.Response, error) {
+	c.authorize(req)
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusUnauthorized {
+		return resp, nil
+	}
+
+	challenge := resp.Header.Get("WWW-Authenticate")
+	resp.Body.Close()
+	if err := c.authenticate(req.Context(), challenge); err != nil {
+		return nil, err
+	}
+
+	retry := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		retry.Body = body
+	}
+	c.authorize(retry)
+	return c.httpClient.Do(retry)
+}
+
+func (c *Client) authorize(req *http.Request) {
+	c.mu.Lock()
+	token := c.token
+	c.mu.Unlock()
+
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	} else if c.username != "" {
+		req.SetBasicAuth(c.username, c.password)
+	}
+}
+
+// authenticate handles a WWW-Authenticate challenge. Basic challenges are
+// answered by authorize, bearer challenges need a token from the realm.
+func (c *Client) authenticate(ctx context.Context, challenge string) error {
+	scheme, params := parseChallenge(challenge)
+	if !strings.EqualFold(scheme, "bearer") {
+		return fmt.Errorf("unauthorized to access the registry, please check your credentials")
+	}
+
+	realm, err := url.Parse(params["realm"])
+	if err != nil || params["realm"] == "" {
+		return fmt.Errorf("invalid authentication realm %q", params["realm"])
+	}
+	query := realm.Query()
+	if service := params["service"]; service != "" {
+		query.Set("service", service)
+	}
+	for _, scope := range strings.Fields(params["scope"]) {
+		query.Add("scope", scope)
+	}
+	realm.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, realm.String(), nil)
+	if err != nil {
+		return err
+	}
+	if c.username != "" {
+		req.SetBasicAuth(c.username, c.password)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to fetch registry token: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to fetch registry token: %s", resp.Status)
+	}
+
+	var token struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return fmt.Errorf("failed to decode registry token: %v", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.token = token.Token
+	if c.token == "" {
+		c.token = token.AccessToken
+	}
+	return nil
+}
+
+// parseChallenge splits a WWW-Authenticate header into its scheme and
+// parameters, honouring quoted values that contain commas.
+func parseChallenge(header string) (string, map[string]string) {
+	params := map[string]string{}
+	header = strings.TrimSpace(header)
+	scheme, rest, _ := strings.Cut(header, " ")
+
+	for rest = strings.TrimSpace(rest); rest != ""; rest = strings.TrimSpace(rest) {
+		key, value, found := strings.Cut(rest, "=")
+		if !found {
+			break
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+
+		if strings.HasPrefix(value, `"`) {
+			end := strings.Index(value[1:], `"`)
+			if end < 0 {
+				params[key] = value[1:]
+				break
+			}
+			params[key] = value[1 : end+1]
+			rest = strings.TrimPrefix(strings.TrimSpace(value[end+2:]), ",")
+		} else {
+			v, r, _ := strings.Cut(value, ",")
+			params[key] = strings.TrimSpace(v)
+			rest = r
+		}
+	}
+
+	return scheme, params
+}
+
+func (c *Client) url(repository, kind, reference string) string {
+	return fmt.Sprintf("%s/v2/%s/%s/%s", c.baseURL, repository, kind, reference)
+}
+
+// FetchManifest downloads the manifest of repository at reference and
+// returns its descriptor together with the raw content.
+func (c *Client) FetchManifest(ctx context.Context, repository, reference string) (Descriptor, []byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url(repository, "manifests", reference), nil)
+	if err != nil {
+		return Descriptor{}, nil, err
+	}
+	req.Header.Set("Accept", strings.Join(manifestMediaTypes, ", "))
+
+	resp, err := c.Do(req)
+	if err != nil {
+		return Descriptor{}, nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return Descriptor{}, nil, fmt.Errorf("failed to fetch manifest %s:%s: %s", repository, reference, resp.Status)
+	}
+
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return Descriptor{}, nil, err
+	}
+
+	digest := Digest(content)
+	if strings.HasPrefix(reference, "sha256:") && reference != digest {
+		return Descriptor{}, nil, fmt.Errorf("manifest digest mismatch: expected %s, got %s", reference, digest)
+	}
+
+	mediaType, _, _ := strings.Cut(resp.Header.Get("Content-Type"), ";")
+	if mediaType == "" {
+		manifest, err := ParseManifest(content)
+		if err == nil {
+			mediaType = manifest.MediaType
+		}
+	}
+
+	return Descriptor{MediaType: mediaType, Digest: digest, Size: int64(len(content))}, content, nil
+}
+
+// FetchBlob downloads a small blob such as an image config into memory
+// and verifies its digest.
+func (c *Client) FetchBlob(ctx context.Context, repository, digest string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url(repository, "blobs", digest), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch blob %s@%s: %s", repository, digest, resp.Status)
+	}
+
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if actual := Digest(content); actual != digest {
+		return nil, fmt.Errorf("blob digest mismatch: expected %s, got %s", digest, actual)
+	}
+	return content, nil
+}
+
+// ParseManifest decodes an image manifest or index.
+func ParseManifest(content []byte) (*Manifest, error) {
+	var manifest Manifest
+	if err := json.Unmarshal(content, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest: %v", err)
+	}
+	return &manifest, nil
+}
+
+// Digest returns the sha256 digest of content.
+func Digest(content []byte) string {
+	sum := sha256.Sum256(content)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// partialSuffix marks blobs whose download has not completed yet.
+const partialSuffix = ".partial"
+
+// DownloadBlob streams a blob to path, verifying its digest. An interrupted
+// download left at path.partial is resumed with a range request when the
+// registry supports it.
+func (c *Client) DownloadBlob(ctx context.Context, repository string, desc Descriptor, path string) error {
+	partial := path + partialSuffix
+
+	file, err := os.OpenFile(partial, os.O_CREATE|os.O_RDWR, 0o644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	offset, err := io.Copy(hash, file)
+	if err != nil {
+		return err
+	}
+	if offset > desc.Size {
+		offset = 0
+	}
+
+	if offset < desc.Size || desc.Size == 0 {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url(repository, "blobs", desc.Digest), nil)
+		if err != nil {
+			return err
+		}
+		if offset > 0 {
+			req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+		}
+
+		resp, err := c.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+
+		switch resp.StatusCode {
+		case http.StatusPartialContent:
+		case http.StatusOK:
+			// The registry ignored the range, start over.
+			offset = 0
+			hash.Reset()
+		default:
+			return fmt.Errorf("failed to fetch blob %s@%s: %s", repository, desc.Digest, resp.Status)
+		}
+
+		if err := file.Truncate(offset); err != nil {
+			return err
+		}
+		if _, err := file.Seek(offset, io.SeekStart); err != nil {
+			return err
+		}
+		if _, err := io.Copy(io.MultiWriter(file, hash), resp.Body); err != nil {
+			return fmt.Errorf("failed to download blob %s: %v", desc.Digest, err)
+		}
+	}
+
+	if actual := "sha256:" + hex.EncodeToString(hash.Sum(nil)); actual != desc.Digest {
+		file.Close()
+		os.Remove(partial)
+		return fmt.Errorf("blob digest mismatch: expected %s, got %s", desc.Digest, actual)
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(partial, path)
+}
+
+// BlobExists reports whether the repository already holds the blob.
+func (c *Client) BlobExists(ctx context.Context, repository, digest string) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, c.url(repository, "blobs", digest), nil)
+	if err != nil {
+		return false, err
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		return false, err
+	}
+	resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		return false, fmt.Errorf("failed to check blob %s@%s: %s", repository, digest, resp.Status)
+	}
+}
+
+// MountBlob asks the registry to link a blob of another repository into
+// repository without uploading it again. It reports whether the blob was
+// mounted; when it was not, the caller has to upload it.
+func (c *Client) MountBlob(ctx context.Context, repository, digest, from string) (bool, error) {
+	query := url.Values{"mount": {digest}, "from": {from}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url(repository, "blobs", "uploads/")+"?"+query.Encode(), nil)
+	if err != nil {
+		return false, err
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusCreated:
+		return true, nil
+	case http.StatusAccepted:
+		// The registry opened an upload session instead, drop it.
+		if location, err := c.location(resp); err == nil {
+			if cancel, err := http.NewRequestWithContext(ctx, http.MethodDelete, location, nil); err == nil {
+				if resp, err := c.Do(cancel); err == nil {
+					resp.Body.Close()
+				}
+			}
+		}
+		return false, nil
+	default:
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return false, fmt.Errorf("failed to mount blob %s from %s: %s %s", digest, from, resp.Status, strings.TrimSpace(string(body)))
+	}
+}
+
+// UploadBlob uploads the blob stored at path in chunks of chunkSize bytes.
+func (c *Client) UploadBlob(ctx context.Context, repository string, desc Descriptor, path string, chunkSize int64) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url(repository, "blobs", "uploads/"), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusAccepted {
+		return fmt.Errorf("failed to start upload of blob %s: %s", desc.Digest, resp.Status)
+	}
+	location, err := c.location(resp)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	buf := make([]byte, chunkSize)
+	var offset int64
+	for offset < desc.Size {
+		n, err := io.ReadFull(file, buf)
+		if err != nil && err != io.ErrUnexpectedEOF {
+			return fmt.Errorf("failed to read blob %s: %v", desc.Digest, err)
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPatch, location, bytes.NewReader(buf[:n]))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/octet-stream")
+		req.Header.Set("Content-Range", fmt.Sprintf("%d-%d", offset, offset+int64(n)-1))
+		resp, err := c.Do(req)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusAccepted {
+			return fmt.Errorf("failed to upload blob %s: %s", desc.Digest, resp.Status)
+		}
+		if location, err = c.location(resp); err != nil {
+			return err
+		}
+		offset += int64(n)
+	}
+
+	complete, err := url.Parse(location)
+	if err != nil {
+		return err
+	}
+	query := complete.Query()
+	query.Set("digest", desc.Digest)
+	complete.RawQuery = query.Encode()
+
+	req, err = http.NewRequestWithContext(ctx, http.MethodPut, complete.String(), nil)
+	if err != nil {
+		return err
+	}
+	resp, err = c.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("failed to complete upload of blob %s: %s", desc.Digest, resp.Status)
+	}
+	return nil
+}
+
+// PushManifest uploads a manifest to repository under reference.
+func (c *Client) PushManifest(ctx context.Context, repository, reference, mediaType string, content []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, c.url(repository, "manifests", reference), bytes.NewReader(content))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", mediaType)
+
+	resp, err := c.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("failed to push manifest %s:%s: %s %s", repository, reference, resp.Status, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
+
+// location resolves the Location header of an upload response.
+func (c *Client) location(resp *http.Response) (string, error) {
+	location := resp.Header.Get("Location")
+	if location == "" {
+		return "", fmt.Errorf("registry did not return an upload location")
+	}
+	u, err := resp.Request.URL.Parse(location)
+	if err != nil {
+		return "", err
+	}
+	return u.String(), nil
+}
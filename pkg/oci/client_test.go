@@ -0,0 +1,152 @@
+package oci
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseChallenge(t *testing.T) {
+	tests := []struct {
+		header     string
+		wantScheme string
+		wantParams map[string]string
+	}{
+		{
+			header:     `Bearer realm="https://harbor.example.com/service/token",service="harbor-registry",scope="repository:library/app:pull,push"`,
+			wantScheme: "Bearer",
+			wantParams: map[string]string{
+				"realm":   "https://harbor.example.com/service/token",
+				"service": "harbor-registry",
+				"scope":   "repository:library/app:pull,push",
+			},
+		},
+		{
+			header:     `Basic realm="harbor"`,
+			wantScheme: "Basic",
+			wantParams: map[string]string{"realm": "harbor"},
+		},
+		{
+			header:     `Bearer realm=https://harbor.example.com/token, service=harbor-registry`,
+			wantScheme: "Bearer",
+			wantParams: map[string]string{"realm": "https://harbor.example.com/token", "service": "harbor-registry"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.wantScheme, func(t *testing.T) {
+			scheme, params := parseChallenge(tt.header)
+			assert.Equal(t, tt.wantScheme, scheme)
+			assert.Equal(t, tt.wantParams, params)
+		})
+	}
+}
+
+func TestClientAuthentication(t *testing.T) {
+	tests := []struct {
+		name     string
+		bearer   bool
+		password string
+		wantErr  string
+	}{
+		{name: "basic", password: "Harbor12345"},
+		{name: "basic with wrong password", password: "wrong", wantErr: "unauthorized to access the registry"},
+		{name: "bearer token exchange", bearer: true, password: "Harbor12345"},
+		{name: "bearer with wrong password", bearer: true, password: "wrong", wantErr: "failed to fetch registry token: 401"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registry := newTestRegistry(t)
+			registry.bearer = tt.bearer
+			desc := registry.putImage("library/app", "v1", Platform{OS: "linux", Architecture: "amd64"}, "layer")
+
+			client := NewClient(registry.URL, registry.username, tt.password)
+			// Two requests check that the token is reused.
+			for i := 0; i < 2; i++ {
+				got, _, err := client.FetchManifest(context.Background(), "library/app", "v1")
+				if tt.wantErr != "" {
+					assert.ErrorContains(t, err, tt.wantErr)
+					return
+				}
+				require.NoError(t, err)
+				assert.Equal(t, desc.Digest, got.Digest)
+				assert.Equal(t, MediaTypeOCIManifest, got.MediaType)
+			}
+			if tt.bearer {
+				assert.Equal(t, []string{"repository:library/app:pull,push"}, registry.tokenScopes)
+			}
+		})
+	}
+}
+
+func TestFetchManifestDigestMismatch(t *testing.T) {
+	registry := newTestRegistry(t)
+	registry.putImage("library/app", "v1", Platform{OS: "linux", Architecture: "amd64"}, "layer")
+	other := registry.putImage("library/app", "v2", Platform{OS: "linux", Architecture: "arm64"}, "other")
+	// Serve the v1 manifest under the digest of v2.
+	registry.manifests["library/app"][other.Digest] = registry.manifests["library/app"]["v1"]
+
+	_, _, err := registry.client().FetchManifest(context.Background(), "library/app", other.Digest)
+	assert.ErrorContains(t, err, "manifest digest mismatch")
+}
+
+func TestFetchBlobDigestMismatch(t *testing.T) {
+	registry := newTestRegistry(t)
+	blob := registry.putBlob("library/app", "application/octet-stream", []byte("config"))
+	registry.corrupt[blob.Digest] = true
+
+	_, err := registry.client().FetchBlob(context.Background(), "library/app", blob.Digest)
+	assert.ErrorContains(t, err, "blob digest mismatch")
+}
+
+func TestDownloadBlob(t *testing.T) {
+	content := []byte("0123456789abcdefghijklmnopqrstuvwxyz")
+
+	tests := []struct {
+		name        string
+		partial     []byte
+		ignoreRange bool
+		corrupt     bool
+		wantRanges  []string
+		wantErr     string
+	}{
+		{name: "fresh download"},
+		{name: "resume partial download", partial: content[:10], wantRanges: []string{"bytes=10-"}},
+		{name: "registry ignoring the range", partial: content[:10], ignoreRange: true, wantRanges: []string{"bytes=10-"}},
+		{name: "partial larger than the blob", partial: append(append([]byte{}, content...), "extra"...)},
+		{name: "digest mismatch", corrupt: true, wantErr: "blob digest mismatch"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registry := newTestRegistry(t)
+			registry.ignoreRange = tt.ignoreRange
+			blob := registry.putBlob("library/app", "application/octet-stream", content)
+			registry.corrupt[blob.Digest] = tt.corrupt
+
+			path := filepath.Join(t.TempDir(), "blob")
+			if tt.partial != nil {
+				require.NoError(t, os.WriteFile(path+partialSuffix, tt.partial, 0o644))
+			}
+
+			err := registry.client().DownloadBlob(context.Background(), "library/app", blob, path)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				assert.NoFileExists(t, path)
+				assert.NoFileExists(t, path+partialSuffix)
+				return
+			}
+			require.NoError(t, err)
+			got, err := os.ReadFile(path)
+			require.NoError(t, err)
+			assert.Equal(t, content, got)
+			assert.NoFileExists(t, path+partialSuffix)
+			assert.Equal(t, tt.wantRanges, registry.ranges)
+		})
+	}
+}
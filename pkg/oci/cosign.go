@@ -0,0 +1,156 @@
+package oci
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"strings"
+)
+
+// Annotations set by cosign on the layers of a signature manifest.
+const (
+	CosignSignatureAnnotation   = "dev.cosignproject.cosign/signature"
+	CosignCertificateAnnotation = "dev.sigstore.cosign/certificate"
+)
+
+// CosignSignature is one signature stored in a cosign signature manifest.
+type CosignSignature struct {
+	Payload     []byte
+	Signature   string
+	Certificate string
+}
+
+// SimpleSigning is the payload signed by cosign.
+type SimpleSigning struct {
+	Critical struct {
+		Identity struct {
+			DockerReference string `json:"docker-reference"`
+		} `json:"identity"`
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+		Type string `json:"type"`
+	} `json:"critical"`
+	Optional map[string]interface{} `json:"optional"`
+}
+
+// FetchCosignSignatures returns the signatures of the cosign signature
+// manifest with the given digest.
+func FetchCosignSignatures(ctx context.Context, client *Client, repository, digest string) ([]CosignSignature, error) {
+	_, content, err := client.FetchManifest(ctx, repository, digest)
+	if err != nil {
+		return nil, err
+	}
+	manifest, err := ParseManifest(content)
+	if err != nil {
+		return nil, err
+	}
+
+	var signatures []CosignSignature
+	for _, layer := range manifest.Layers {
+		signature, ok := layer.Annotations[CosignSignatureAnnotation]
+		if !ok {
+			continue
+		}
+		payload, err := client.FetchBlob(ctx, repository, layer.Digest)
+		if err != nil {
+			return nil, err
+		}
+		signatures = append(signatures, CosignSignature{
+			Payload:     payload,
+			Signature:   signature,
+			Certificate: layer.Annotations[CosignCertificateAnnotation],
+		})
+	}
+	return signatures, nil
+}
+
+// LoadPublicKey parses a PEM encoded public key such as cosign.pub.
+func LoadPublicKey(content []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(content)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM encoded public key found")
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse public key: %v", err)
+	}
+	return key, nil
+}
+
+// KeyFingerprint returns the sha256 fingerprint of a public key.
+func KeyFingerprint(key crypto.PublicKey) string {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(der)
+	return "SHA256:" + hex.EncodeToString(sum[:])
+}
+
+// Verify checks the signature against key and that the signed payload
+// refers to digest, returning the payload on success.
+func (s CosignSignature) Verify(key crypto.PublicKey, digest string) (SimpleSigning, error) {
+	var payload SimpleSigning
+
+	signature, err := base64.StdEncoding.DecodeString(s.Signature)
+	if err != nil {
+		return payload, fmt.Errorf("invalid signature encoding: %v", err)
+	}
+
+	hash := sha256.Sum256(s.Payload)
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(k, hash[:], signature) {
+			return payload, fmt.Errorf("signature does not match the key")
+		}
+	case *rsa.PublicKey:
+		if err := rsa.VerifyPKCS1v15(k, crypto.SHA256, hash[:], signature); err != nil {
+			return payload, fmt.Errorf("signature does not match the key")
+		}
+	case ed25519.PublicKey:
+		if !ed25519.Verify(k, s.Payload, signature) {
+			return payload, fmt.Errorf("signature does not match the key")
+		}
+	default:
+		return payload, fmt.Errorf("unsupported public key type %T", key)
+	}
+
+	if err := json.Unmarshal(s.Payload, &payload); err != nil {
+		return payload, fmt.Errorf("invalid signature payload: %v", err)
+	}
+	if signed := payload.Critical.Image.DockerManifestDigest; signed != digest {
+		return payload, fmt.Errorf("signature is for %s, not %s", signed, digest)
+	}
+	return payload, nil
+}
+
+// Signer describes who produced the signature: the subject of the signing
+// certificate for keyless signatures, otherwise the fingerprint of key.
+func (s CosignSignature) Signer(key crypto.PublicKey) string {
+	if block, _ := pem.Decode([]byte(s.Certificate)); block != nil {
+		if cert, err := x509.ParseCertificate(block.Bytes); err == nil {
+			var names []string
+			names = append(names, cert.EmailAddresses...)
+			for _, uri := range cert.URIs {
+				names = append(names, uri.String())
+			}
+			if len(names) > 0 {
+				return strings.Join(names, ",")
+			}
+			if cert.Subject.CommonName != "" {
+				return cert.Subject.CommonName
+			}
+		}
+	}
+	return KeyFingerprint(key)
+}
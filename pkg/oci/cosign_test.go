@@ -0,0 +1,178 @@
+package oci
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const signedDigest = "sha256:6c3c624b58dbbcd3c0dd82b4c53f04194d1247c6eebdaab7c610cf7d66709b3b"
+
+func cosignPayload(digest string) []byte {
+	return []byte(fmt.Sprintf(`{"critical":{"identity":{"docker-reference":"harbor.example.com/library/app"},"image":{"docker-manifest-digest":%q},"type":"cosign container image signature"},"optional":{"creator":"ci"}}`, digest))
+}
+
+// sign signs payload the way cosign does for each key type.
+func sign(t *testing.T, key crypto.Signer, payload []byte) string {
+	t.Helper()
+	var (
+		signature []byte
+		err       error
+	)
+	hash := sha256.Sum256(payload)
+	switch k := key.(type) {
+	case *ecdsa.PrivateKey:
+		signature, err = ecdsa.SignASN1(rand.Reader, k, hash[:])
+	case *rsa.PrivateKey:
+		signature, err = rsa.SignPKCS1v15(rand.Reader, k, crypto.SHA256, hash[:])
+	case ed25519.PrivateKey:
+		signature = ed25519.Sign(k, payload)
+	}
+	require.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(signature)
+}
+
+func TestCosignSignatureVerify(t *testing.T) {
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	_, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	payload := cosignPayload(signedDigest)
+	tampered := cosignPayload("sha256:0000000000000000000000000000000000000000000000000000000000000000")
+
+	tests := []struct {
+		name      string
+		signature CosignSignature
+		key       crypto.PublicKey
+		wantErr   string
+	}{
+		{
+			name:      "ecdsa",
+			signature: CosignSignature{Payload: payload, Signature: sign(t, ecdsaKey, payload)},
+			key:       ecdsaKey.Public(),
+		},
+		{
+			name:      "rsa",
+			signature: CosignSignature{Payload: payload, Signature: sign(t, rsaKey, payload)},
+			key:       rsaKey.Public(),
+		},
+		{
+			name:      "ed25519",
+			signature: CosignSignature{Payload: payload, Signature: sign(t, ed25519Key, payload)},
+			key:       ed25519Key.Public(),
+		},
+		{
+			name:      "wrong key",
+			signature: CosignSignature{Payload: payload, Signature: sign(t, ecdsaKey, payload)},
+			key:       otherKey.Public(),
+			wantErr:   "signature does not match the key",
+		},
+		{
+			name:      "wrong key type",
+			signature: CosignSignature{Payload: payload, Signature: sign(t, ecdsaKey, payload)},
+			key:       rsaKey.Public(),
+			wantErr:   "signature does not match the key",
+		},
+		{
+			name:      "tampered payload",
+			signature: CosignSignature{Payload: tampered, Signature: sign(t, ecdsaKey, payload)},
+			key:       ecdsaKey.Public(),
+			wantErr:   "signature does not match the key",
+		},
+		{
+			name:      "signed for another digest",
+			signature: CosignSignature{Payload: tampered, Signature: sign(t, ecdsaKey, tampered)},
+			key:       ecdsaKey.Public(),
+			wantErr:   "signature is for sha256:0000",
+		},
+		{
+			name:      "invalid encoding",
+			signature: CosignSignature{Payload: payload, Signature: "not base64!"},
+			key:       ecdsaKey.Public(),
+			wantErr:   "invalid signature encoding",
+		},
+		{
+			name:      "invalid payload",
+			signature: CosignSignature{Payload: []byte("{"), Signature: sign(t, ecdsaKey, []byte("{"))},
+			key:       ecdsaKey.Public(),
+			wantErr:   "invalid signature payload",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signed, err := tt.signature.Verify(tt.key, signedDigest)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, signedDigest, signed.Critical.Image.DockerManifestDigest)
+			assert.Equal(t, "harbor.example.com/library/app", signed.Critical.Identity.DockerReference)
+		})
+	}
+}
+
+func TestLoadPublicKey(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(key.Public())
+	require.NoError(t, err)
+
+	loaded, err := LoadPublicKey(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+	require.NoError(t, err)
+	assert.True(t, key.PublicKey.Equal(loaded))
+	assert.Equal(t, KeyFingerprint(key.Public()), KeyFingerprint(loaded))
+	assert.Regexp(t, `^SHA256:[0-9a-f]{64}$`, KeyFingerprint(loaded))
+
+	_, err = LoadPublicKey([]byte("not a key"))
+	assert.ErrorContains(t, err, "no PEM encoded public key found")
+	_, err = LoadPublicKey(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: []byte("garbage")}))
+	assert.ErrorContains(t, err, "failed to parse public key")
+}
+
+func TestFetchCosignSignatures(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	payload := cosignPayload(signedDigest)
+	signature := sign(t, key, payload)
+
+	registry := newTestRegistry(t)
+	layer := registry.putBlob("library/app", "application/vnd.dev.cosign.simplesigning.v1+json", payload)
+	layer.Annotations = map[string]string{CosignSignatureAnnotation: signature}
+	unsigned := registry.putBlob("library/app", "application/octet-stream", []byte("attachment"))
+	manifest := registry.putManifest("library/app", "", Manifest{
+		SchemaVersion: 2,
+		MediaType:     MediaTypeOCIManifest,
+		Config:        ptr(registry.putBlob("library/app", "application/vnd.oci.image.config.v1+json", []byte("{}"))),
+		Layers:        []Descriptor{layer, unsigned},
+	})
+
+	signatures, err := FetchCosignSignatures(context.Background(), registry.client(), "library/app", manifest.Digest)
+	require.NoError(t, err)
+	require.Len(t, signatures, 1)
+	assert.Equal(t, payload, signatures[0].Payload)
+	assert.Equal(t, signature, signatures[0].Signature)
+
+	_, err = signatures[0].Verify(key.Public(), signedDigest)
+	assert.NoError(t, err)
+	assert.Equal(t, KeyFingerprint(key.Public()), signatures[0].Signer(key.Public()))
+}
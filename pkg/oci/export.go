@@ -0,0 +1,208 @@
+package oci
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/goharbor/harbor-cli/pkg/utils"
+)
+
+// ExportOptions controls what Export copies into a layout.
+type ExportOptions struct {
+	// Platform selects a single manifest of an image index, all platforms
+	// are exported when empty.
+	Platform string
+	// RefName names the exported manifest in the index of the layout.
+	RefName string
+	// Workers is the number of concurrent blob downloads.
+	Workers int
+}
+
+// ExportResult summarises an export.
+type ExportResult struct {
+	Descriptor Descriptor
+	Blobs      int
+	Downloaded int64
+	Reused     int
+}
+
+// Export copies the artifact at reference with all its manifests and blobs
+// from the registry into layout.
+func Export(ctx context.Context, client *Client, layout *Layout, repository, reference string, opts ExportOptions) (ExportResult, error) {
+	var result ExportResult
+
+	desc, content, err := client.FetchManifest(ctx, repository, reference)
+	if err != nil {
+		return result, err
+	}
+	manifest, err := ParseManifest(content)
+	if err != nil {
+		return result, err
+	}
+
+	if opts.Platform != "" {
+		if !manifest.IsIndex() {
+			return result, fmt.Errorf("%s:%s is not an image index, it has no platforms to select from", repository, reference)
+		}
+		child, err := selectPlatform(manifest, opts.Platform)
+		if err != nil {
+			return result, err
+		}
+		desc, content, err = client.FetchManifest(ctx, repository, child.Digest)
+		if err != nil {
+			return result, err
+		}
+		if manifest, err = ParseManifest(content); err != nil {
+			return result, err
+		}
+	}
+
+	if err := layout.WriteBlob(desc.Digest, content); err != nil {
+		return result, err
+	}
+
+	blobs, err := collectBlobs(ctx, client, layout, repository, manifest)
+	if err != nil {
+		return result, err
+	}
+
+	result.Blobs = len(blobs)
+	result.Downloaded, result.Reused, err = downloadBlobs(ctx, client, layout, repository, blobs, opts.Workers)
+	if err != nil {
+		return result, err
+	}
+
+	if err := layout.AddManifest(desc, opts.RefName); err != nil {
+		return result, err
+	}
+	result.Descriptor = desc
+	return result, nil
+}
+
+// collectBlobs stores the child manifests of an index, and of the indexes
+// nested in it, in the layout and returns the config and layer blobs of all
+// manifests, without duplicates.
+func collectBlobs(ctx context.Context, client *Client, layout *Layout, repository string, manifest *Manifest) ([]Descriptor, error) {
+	seen := map[string]bool{}
+	var blobs []Descriptor
+	add := func(d Descriptor) {
+		// Foreign layers are not distributed by the registry.
+		if len(d.URLs) > 0 || seen[d.Digest] {
+			return
+		}
+		seen[d.Digest] = true
+		blobs = append(blobs, d)
+	}
+
+	var walk func(m *Manifest) error
+	walk = func(m *Manifest) error {
+		if m.Config != nil {
+			add(*m.Config)
+		}
+		for _, layer := range m.Layers {
+			add(layer)
+		}
+
+		for _, child := range m.Manifests {
+			if seen[child.Digest] {
+				continue
+			}
+			seen[child.Digest] = true
+
+			_, content, err := client.FetchManifest(ctx, repository, child.Digest)
+			if err != nil {
+				return err
+			}
+			if err := layout.WriteBlob(child.Digest, content); err != nil {
+				return err
+			}
+			childManifest, err := ParseManifest(content)
+			if err != nil {
+				return err
+			}
+			if err := walk(childManifest); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if err := walk(manifest); err != nil {
+		return nil, err
+	}
+	return blobs, nil
+}
+
+// downloadBlobs fetches the blobs missing from the layout with a pool of
+// workers and returns the number of bytes downloaded and of blobs reused.
+func downloadBlobs(ctx context.Context, client *Client, layout *Layout, repository string, blobs []Descriptor, workers int) (int64, int, error) {
+	if workers < 1 {
+		workers = 1
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu         sync.Mutex
+		firstErr   error
+		downloaded int64
+		reused     int
+		wg         sync.WaitGroup
+	)
+	jobs := make(chan Descriptor)
+
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for blob := range jobs {
+				if layout.VerifyBlob(blob) == nil {
+					mu.Lock()
+					reused++
+					mu.Unlock()
+					continue
+				}
+				err := client.DownloadBlob(ctx, repository, blob, layout.BlobPath(blob.Digest))
+
+				mu.Lock()
+				if err != nil && firstErr == nil {
+					firstErr = err
+					cancel()
+				} else if err == nil {
+					downloaded += blob.Size
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for _, blob := range blobs {
+		select {
+		case jobs <- blob:
+		case <-ctx.Done():
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	return downloaded, reused, firstErr
+}
+
+// selectPlatform returns the manifest of index built for platform.
+func selectPlatform(index *Manifest, platform string) (Descriptor, error) {
+	osName, arch, variant, err := utils.ParsePlatform(platform)
+	if err != nil {
+		return Descriptor{}, err
+	}
+	for _, m := range index.Manifests {
+		if m.Platform == nil || m.Platform.OS != osName || m.Platform.Architecture != arch {
+			continue
+		}
+		if variant == "" || m.Platform.Variant == variant {
+			return m, nil
+		}
+	}
+	return Descriptor{}, fmt.Errorf("no manifest for platform %s in the index", platform)
+}
@@ -0,0 +1,172 @@
+package oci
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExport(t *testing.T) {
+	registry := newTestRegistry(t)
+	amd64 := registry.putImage("library/app", "", Platform{OS: "linux", Architecture: "amd64"}, "base", "app-amd64")
+	arm64 := registry.putImage("library/app", "", Platform{OS: "linux", Architecture: "arm64", Variant: "v8"}, "base", "app-arm64")
+	windows := registry.putImage("library/app", "", Platform{OS: "windows", Architecture: "amd64"}, "app-windows")
+	// An index of indexes, as produced by tools grouping images per OS.
+	linux := registry.putIndex("library/app", "", amd64, arm64)
+	registry.putIndex("library/app", "v1", linux, windows)
+
+	tests := []struct {
+		name       string
+		opts       ExportOptions
+		wantBlobs  int
+		wantLayers []string
+		wantErr    string
+	}{
+		{
+			name:       "nested index",
+			opts:       ExportOptions{RefName: "v1", Workers: 2},
+			wantBlobs:  7,
+			wantLayers: []string{"base", "app-amd64", "app-arm64", "app-windows"},
+		},
+		{
+			name:       "platform",
+			opts:       ExportOptions{RefName: "v1-windows", Platform: "windows/amd64"},
+			wantBlobs:  2,
+			wantLayers: []string{"app-windows"},
+		},
+		{
+			name:    "unknown platform",
+			opts:    ExportOptions{Platform: "linux/s390x"},
+			wantErr: "no manifest for platform linux/s390x",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			layout, err := NewLayout(filepath.Join(t.TempDir(), "layout"))
+			require.NoError(t, err)
+
+			result, err := Export(context.Background(), registry.client(), layout, "library/app", "v1", tt.opts)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantBlobs, result.Blobs)
+			assert.Equal(t, 0, result.Reused)
+
+			desc, err := layout.FindManifest(tt.opts.RefName)
+			require.NoError(t, err)
+			assert.Equal(t, result.Descriptor.Digest, desc.Digest)
+			for _, layer := range tt.wantLayers {
+				content, err := layout.ReadBlob(Digest([]byte(layer)))
+				require.NoError(t, err, "layer %s", layer)
+				assert.Equal(t, layer, string(content))
+			}
+			assertLayoutComplete(t, layout, desc)
+
+			// Exporting again reuses the blobs already in the layout.
+			again, err := Export(context.Background(), registry.client(), layout, "library/app", "v1", tt.opts)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantBlobs, again.Reused)
+			assert.Equal(t, int64(0), again.Downloaded)
+		})
+	}
+}
+
+func TestExportDigestMismatch(t *testing.T) {
+	registry := newTestRegistry(t)
+	registry.putImage("library/app", "v1", Platform{OS: "linux", Architecture: "amd64"}, "layer")
+	registry.corrupt[Digest([]byte("layer"))] = true
+
+	layout, err := NewLayout(t.TempDir())
+	require.NoError(t, err)
+	_, err = Export(context.Background(), registry.client(), layout, "library/app", "v1", ExportOptions{})
+	assert.ErrorContains(t, err, "blob digest mismatch")
+	assert.NoFileExists(t, layout.BlobPath(Digest([]byte("layer"))))
+
+	index, err := layout.Index()
+	require.NoError(t, err)
+	assert.Empty(t, index.Manifests, "a failed export must not be recorded in the index")
+}
+
+func TestLayoutTar(t *testing.T) {
+	registry := newTestRegistry(t)
+	amd64 := registry.putImage("library/app", "", Platform{OS: "linux", Architecture: "amd64"}, "layer-1", "layer-2")
+	registry.putIndex("library/app", "v1", amd64)
+
+	source, err := NewLayout(filepath.Join(t.TempDir(), "source"))
+	require.NoError(t, err)
+	_, err = Export(context.Background(), registry.client(), source, "library/app", "v1", ExportOptions{RefName: "v1"})
+	require.NoError(t, err)
+	// Leftovers of interrupted downloads are left out of the archive.
+	require.NoError(t, os.WriteFile(source.BlobPath("sha256:abc")+partialSuffix, []byte("partial"), 0o644))
+
+	var archive bytes.Buffer
+	require.NoError(t, source.WriteTar(&archive))
+
+	dir := filepath.Join(t.TempDir(), "extracted")
+	require.NoError(t, ExtractTar(&archive, dir))
+	assert.NoFileExists(t, filepath.Join(dir, "blobs", "sha256", "abc"+partialSuffix))
+
+	extracted, err := OpenLayout(dir)
+	require.NoError(t, err)
+	desc, err := extracted.FindManifest("v1")
+	require.NoError(t, err)
+	assertLayoutComplete(t, extracted, desc)
+}
+
+func TestExtractTarRejectsEscapingPaths(t *testing.T) {
+	var archive bytes.Buffer
+	tw := tar.NewWriter(&archive)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "../../evil", Mode: 0o644, Size: 4, Typeflag: tar.TypeReg}))
+	_, err := tw.Write([]byte("evil"))
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+
+	dir := t.TempDir()
+	err = ExtractTar(&archive, filepath.Join(dir, "layout"))
+	assert.ErrorContains(t, err, "invalid path in archive")
+	assert.NoFileExists(t, filepath.Join(dir, "evil"))
+}
+
+func TestOpenLayout(t *testing.T) {
+	_, err := OpenLayout(t.TempDir())
+	assert.ErrorContains(t, err, "is not an OCI image layout")
+
+	dir := t.TempDir()
+	_, err = NewLayout(dir)
+	require.NoError(t, err)
+	marker, err := os.ReadFile(filepath.Join(dir, layoutFile))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"imageLayoutVersion":"1.0.0"}`, string(marker))
+	_, err = OpenLayout(dir)
+	assert.NoError(t, err)
+}
+
+// assertLayoutComplete checks that the layout holds every manifest and blob
+// reachable from desc with the right digest and size.
+func assertLayoutComplete(t *testing.T, layout *Layout, desc Descriptor) {
+	t.Helper()
+	require.NoError(t, layout.VerifyBlob(desc))
+	content, err := layout.ReadBlob(desc.Digest)
+	require.NoError(t, err)
+	manifest, err := ParseManifest(content)
+	require.NoError(t, err)
+
+	if manifest.Config != nil {
+		assert.NoError(t, layout.VerifyBlob(*manifest.Config))
+	}
+	for _, layer := range manifest.Layers {
+		assert.NoError(t, layout.VerifyBlob(layer))
+	}
+	for _, child := range manifest.Manifests {
+		assertLayoutComplete(t, layout, child)
+	}
+}
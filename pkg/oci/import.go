@@ -0,0 +1,217 @@
+package oci
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// ImportOptions controls how Import pushes a layout.
+type ImportOptions struct {
+	// MountFrom lists repositories of the registry whose blobs are mounted
+	// instead of uploaded when they already hold them.
+	MountFrom []string
+	// ChunkSize is the size of the chunks blobs are uploaded in.
+	ChunkSize int64
+	// Workers is the number of concurrent blob uploads.
+	Workers int
+}
+
+// ImportResult summarises an import.
+type ImportResult struct {
+	Descriptor Descriptor
+	Blobs      int
+	Uploaded   int64
+	Mounted    int
+	Existing   int
+}
+
+// Import pushes the manifest named refName in layout with its blobs to
+// repository and tags it with tag, or pushes it by digest when tag is empty.
+func Import(ctx context.Context, client *Client, layout *Layout, refName, repository, tag string, opts ImportOptions) (ImportResult, error) {
+	var result ImportResult
+
+	desc, err := layout.FindManifest(refName)
+	if err != nil {
+		return result, err
+	}
+	content, err := layout.ReadBlob(desc.Digest)
+	if err != nil {
+		return result, err
+	}
+	if actual := Digest(content); actual != desc.Digest {
+		return result, fmt.Errorf("manifest digest mismatch: expected %s, got %s", desc.Digest, actual)
+	}
+	manifest, err := ParseManifest(content)
+	if err != nil {
+		return result, err
+	}
+	if desc.MediaType == "" {
+		desc.MediaType = manifest.MediaType
+	}
+
+	// Children of an index are pushed by digest before the index itself,
+	// nested indexes after their own children.
+	type pending struct {
+		desc    Descriptor
+		content []byte
+	}
+	var children []pending
+	manifests := []*Manifest{manifest}
+	visited := map[string]bool{desc.Digest: true}
+	var walk func(m *Manifest) error
+	walk = func(m *Manifest) error {
+		for _, child := range m.Manifests {
+			if visited[child.Digest] {
+				continue
+			}
+			visited[child.Digest] = true
+			childContent, err := layout.ReadBlob(child.Digest)
+			if err != nil {
+				return fmt.Errorf("manifest %s is missing from the layout: %v", child.Digest, err)
+			}
+			if actual := Digest(childContent); actual != child.Digest {
+				return fmt.Errorf("manifest digest mismatch: expected %s, got %s", child.Digest, actual)
+			}
+			childManifest, err := ParseManifest(childContent)
+			if err != nil {
+				return err
+			}
+			if err := walk(childManifest); err != nil {
+				return err
+			}
+			manifests = append(manifests, childManifest)
+			children = append(children, pending{desc: child, content: childContent})
+		}
+		return nil
+	}
+	if err := walk(manifest); err != nil {
+		return result, err
+	}
+
+	seen := map[string]bool{}
+	var blobs []Descriptor
+	for _, m := range manifests {
+		descs := m.Layers
+		if m.Config != nil {
+			descs = append([]Descriptor{*m.Config}, descs...)
+		}
+		for _, d := range descs {
+			if len(d.URLs) > 0 || seen[d.Digest] {
+				continue
+			}
+			seen[d.Digest] = true
+			blobs = append(blobs, d)
+		}
+	}
+
+	result.Blobs = len(blobs)
+	if err := uploadBlobs(ctx, client, layout, repository, blobs, opts, &result); err != nil {
+		return result, err
+	}
+
+	for _, child := range children {
+		if err := client.PushManifest(ctx, repository, child.desc.Digest, child.desc.MediaType, child.content); err != nil {
+			return result, err
+		}
+	}
+
+	reference := tag
+	if reference == "" {
+		reference = desc.Digest
+	}
+	if err := client.PushManifest(ctx, repository, reference, desc.MediaType, content); err != nil {
+		return result, err
+	}
+
+	result.Descriptor = desc
+	return result, nil
+}
+
+// uploadBlobs pushes the blobs the repository does not hold yet with a
+// pool of workers, mounting them from opts.MountFrom when possible.
+func uploadBlobs(ctx context.Context, client *Client, layout *Layout, repository string, blobs []Descriptor, opts ImportOptions, result *ImportResult) error {
+	workers := opts.Workers
+	if workers < 1 {
+		workers = 1
+	}
+	chunkSize := opts.ChunkSize
+	if chunkSize <= 0 {
+		chunkSize = 16 << 20
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu       sync.Mutex
+		firstErr error
+		wg       sync.WaitGroup
+	)
+	jobs := make(chan Descriptor)
+
+	upload := func(blob Descriptor) error {
+		exists, err := client.BlobExists(ctx, repository, blob.Digest)
+		if err != nil {
+			return err
+		}
+		if exists {
+			mu.Lock()
+			result.Existing++
+			mu.Unlock()
+			return nil
+		}
+
+		for _, from := range opts.MountFrom {
+			mounted, err := client.MountBlob(ctx, repository, blob.Digest, from)
+			if err != nil {
+				return err
+			}
+			if mounted {
+				mu.Lock()
+				result.Mounted++
+				mu.Unlock()
+				return nil
+			}
+		}
+
+		if err := layout.VerifyBlob(blob); err != nil {
+			return err
+		}
+		if err := client.UploadBlob(ctx, repository, blob, layout.BlobPath(blob.Digest), chunkSize); err != nil {
+			return err
+		}
+		mu.Lock()
+		result.Uploaded += blob.Size
+		mu.Unlock()
+		return nil
+	}
+
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for blob := range jobs {
+				if err := upload(blob); err != nil {
+					mu.Lock()
+					if firstErr == nil {
+						firstErr = err
+						cancel()
+					}
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+
+	for _, blob := range blobs {
+		select {
+		case jobs <- blob:
+		case <-ctx.Done():
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	return firstErr
+}
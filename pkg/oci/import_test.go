@@ -0,0 +1,194 @@
+package oci
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// exportedLayout exports a nested index tagged v1 from library/app into a
+// new layout and returns the registry and the layout.
+func exportedLayout(t *testing.T) (*testRegistry, *Layout) {
+	t.Helper()
+	registry := newTestRegistry(t)
+	amd64 := registry.putImage("library/app", "", Platform{OS: "linux", Architecture: "amd64"}, "base", "app-amd64")
+	arm64 := registry.putImage("library/app", "", Platform{OS: "linux", Architecture: "arm64"}, "base", "app-arm64")
+	linux := registry.putIndex("library/app", "", amd64, arm64)
+	registry.putIndex("library/app", "v1", linux)
+
+	layout, err := NewLayout(t.TempDir())
+	require.NoError(t, err)
+	_, err = Export(context.Background(), registry.client(), layout, "library/app", "v1", ExportOptions{RefName: "v1"})
+	require.NoError(t, err)
+	registry.requests = nil
+	return registry, layout
+}
+
+func TestImport(t *testing.T) {
+	tests := []struct {
+		name        string
+		tag         string
+		opts        ImportOptions
+		mountStatus int
+		wantMounted int
+		wantUpload  bool
+		wantErr     string
+	}{
+		{
+			name:       "upload in chunks",
+			tag:        "v1",
+			opts:       ImportOptions{ChunkSize: 4, Workers: 2},
+			wantUpload: true,
+		},
+		{
+			name:        "mount from source repository",
+			tag:         "v1",
+			opts:        ImportOptions{MountFrom: []string{"library/app"}},
+			wantMounted: 5,
+		},
+		{
+			name:       "mount falls back to upload",
+			opts:       ImportOptions{MountFrom: []string{"library/other"}},
+			wantUpload: true,
+		},
+		{
+			name:        "mount refused",
+			opts:        ImportOptions{MountFrom: []string{"library/app"}},
+			mountStatus: http.StatusForbidden,
+			wantErr:     "failed to mount blob",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registry, layout := exportedLayout(t)
+			registry.mountStatus = tt.mountStatus
+			source, err := layout.FindManifest("v1")
+			require.NoError(t, err)
+
+			result, err := Import(context.Background(), registry.client(), layout, "v1", "library/copy", tt.tag, tt.opts)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				assert.ErrorContains(t, err, "403 Forbidden")
+				assert.NotContains(t, registry.requests, "PUT /v2/library/copy/manifests/"+source.Digest)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, source.Digest, result.Descriptor.Digest)
+			assert.Equal(t, 5, result.Blobs)
+			assert.Equal(t, tt.wantMounted, result.Mounted)
+			var wantUploaded int64
+			if tt.wantUpload {
+				for _, content := range registry.blobs["library/app"] {
+					wantUploaded += int64(len(content))
+				}
+			}
+			assert.Equal(t, wantUploaded, result.Uploaded)
+
+			// Every manifest is pushed after the manifests it references.
+			pushed := manifestPushes(registry.requests)
+			require.Len(t, pushed, 4)
+			reference := tt.tag
+			if reference == "" {
+				reference = source.Digest
+			}
+			assert.Equal(t, reference, pushed[3], "the imported manifest is pushed last")
+
+			// The copy exports to the same content as the source.
+			copied, err := NewLayout(t.TempDir())
+			require.NoError(t, err)
+			exported, err := Export(context.Background(), registry.client(), copied, "library/copy", reference, ExportOptions{RefName: "copy"})
+			require.NoError(t, err)
+			assert.Equal(t, source.Digest, exported.Descriptor.Digest)
+			assertLayoutComplete(t, copied, exported.Descriptor)
+		})
+	}
+}
+
+func TestImportExistingBlobs(t *testing.T) {
+	registry, layout := exportedLayout(t)
+	_, err := Import(context.Background(), registry.client(), layout, "v1", "library/copy", "v1", ImportOptions{})
+	require.NoError(t, err)
+	registry.requests = nil
+
+	result, err := Import(context.Background(), registry.client(), layout, "v1", "library/copy", "v2", ImportOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, 5, result.Existing)
+	assert.Equal(t, int64(0), result.Uploaded)
+	for _, request := range registry.requests {
+		assert.NotContains(t, request, "/blobs/uploads/")
+	}
+}
+
+func TestImportCorruptLayout(t *testing.T) {
+	registry, layout := exportedLayout(t)
+	path := layout.BlobPath(Digest([]byte("app-arm64")))
+	require.NoError(t, os.Chmod(path, 0o644))
+	require.NoError(t, os.WriteFile(path, []byte("app-arm65"), 0o644))
+
+	_, err := Import(context.Background(), registry.client(), layout, "v1", "library/copy", "v1", ImportOptions{})
+	assert.ErrorContains(t, err, "blob digest mismatch")
+	assert.Empty(t, manifestPushes(registry.requests))
+}
+
+func TestMountBlob(t *testing.T) {
+	registry := newTestRegistry(t)
+	desc := registry.putBlob("library/app", "application/octet-stream", []byte("layer"))
+	client := registry.client()
+
+	mounted, err := client.MountBlob(context.Background(), "library/copy", desc.Digest, "library/app")
+	require.NoError(t, err)
+	assert.True(t, mounted)
+
+	// A missing source opens an upload session, which is cancelled.
+	mounted, err = client.MountBlob(context.Background(), "library/copy", Digest([]byte("other")), "library/app")
+	require.NoError(t, err)
+	assert.False(t, mounted)
+	assert.Contains(t, registry.requests, "DELETE /v2/library/copy/blobs/uploads/1")
+	assert.Empty(t, registry.uploads)
+
+	registry.mountStatus = http.StatusInternalServerError
+	_, err = client.MountBlob(context.Background(), "library/copy", desc.Digest, "library/app")
+	assert.ErrorContains(t, err, "500 Internal Server Error")
+}
+
+func TestUploadBlob(t *testing.T) {
+	registry := newTestRegistry(t)
+	registry.bearer = true
+	content := []byte("0123456789")
+	desc := Descriptor{Digest: Digest(content), Size: int64(len(content))}
+	path := filepath.Join(t.TempDir(), "blob")
+	require.NoError(t, os.WriteFile(path, content, 0o644))
+
+	require.NoError(t, registry.client().UploadBlob(context.Background(), "library/app", desc, path, 4))
+	assert.Equal(t, content, registry.blobs["library/app"][desc.Digest])
+
+	var patches int
+	for _, request := range registry.requests {
+		if strings.HasPrefix(request, http.MethodPatch) {
+			patches++
+		}
+	}
+	assert.Equal(t, 3, patches)
+
+	wrong := Descriptor{Digest: Digest([]byte("something else")), Size: desc.Size}
+	err := registry.client().UploadBlob(context.Background(), "library/app", wrong, path, 4)
+	assert.ErrorContains(t, err, "failed to complete upload")
+}
+
+// manifestPushes returns the references of the manifests pushed, in order.
+func manifestPushes(requests []string) []string {
+	var references []string
+	for _, request := range requests {
+		if method, path, _ := strings.Cut(request, " "); method == http.MethodPut && strings.Contains(path, "/manifests/") {
+			references = append(references, path[strings.LastIndex(path, "/")+1:])
+		}
+	}
+	return references
+}
@@ -0,0 +1,269 @@
+package oci
+
+import (
+	"archive/tar"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+const (
+	layoutFile    = "oci-layout"
+	indexFile     = "index.json"
+	layoutVersion = "1.0.0"
+
+	// AnnotationRefName names a manifest in the index of an image layout.
+	AnnotationRefName = "org.opencontainers.image.ref.name"
+)
+
+// Layout is an OCI image layout directory.
+type Layout struct {
+	root string
+	mu   sync.Mutex
+}
+
+// NewLayout creates the image layout at root, or opens it when it exists.
+func NewLayout(root string) (*Layout, error) {
+	if err := os.MkdirAll(filepath.Join(root, "blobs", "sha256"), 0o755); err != nil {
+		return nil, err
+	}
+
+	marker := filepath.Join(root, layoutFile)
+	if _, err := os.Stat(marker); os.IsNotExist(err) {
+		content, _ := json.Marshal(map[string]string{"imageLayoutVersion": layoutVersion})
+		if err := os.WriteFile(marker, content, 0o644); err != nil {
+			return nil, err
+		}
+	}
+
+	index := filepath.Join(root, indexFile)
+	if _, err := os.Stat(index); os.IsNotExist(err) {
+		content, _ := json.Marshal(Manifest{SchemaVersion: 2, MediaType: MediaTypeOCIIndex, Manifests: []Descriptor{}})
+		if err := os.WriteFile(index, content, 0o644); err != nil {
+			return nil, err
+		}
+	}
+
+	return &Layout{root: root}, nil
+}
+
+// OpenLayout opens an existing image layout.
+func OpenLayout(root string) (*Layout, error) {
+	content, err := os.ReadFile(filepath.Join(root, layoutFile))
+	if err != nil {
+		return nil, fmt.Errorf("%s is not an OCI image layout: %v", root, err)
+	}
+	var marker struct {
+		ImageLayoutVersion string `json:"imageLayoutVersion"`
+	}
+	if err := json.Unmarshal(content, &marker); err != nil || marker.ImageLayoutVersion == "" {
+		return nil, fmt.Errorf("%s is not an OCI image layout: invalid %s", root, layoutFile)
+	}
+	return &Layout{root: root}, nil
+}
+
+// Root returns the directory of the layout.
+func (l *Layout) Root() string {
+	return l.root
+}
+
+// BlobPath returns the path of the blob with the given digest.
+func (l *Layout) BlobPath(digest string) string {
+	algorithm, hash, _ := strings.Cut(digest, ":")
+	return filepath.Join(l.root, "blobs", algorithm, hash)
+}
+
+// WriteBlob stores content after verifying it matches digest.
+func (l *Layout) WriteBlob(digest string, content []byte) error {
+	if actual := Digest(content); actual != digest {
+		return fmt.Errorf("blob digest mismatch: expected %s, got %s", digest, actual)
+	}
+	return os.WriteFile(l.BlobPath(digest), content, 0o644)
+}
+
+// ReadBlob returns the content of a blob.
+func (l *Layout) ReadBlob(digest string) ([]byte, error) {
+	return os.ReadFile(l.BlobPath(digest))
+}
+
+// Index returns the index of the layout.
+func (l *Layout) Index() (*Manifest, error) {
+	content, err := os.ReadFile(filepath.Join(l.root, indexFile))
+	if err != nil {
+		return nil, err
+	}
+	return ParseManifest(content)
+}
+
+// AddManifest records desc in the index of the layout under refName,
+// replacing a previous manifest with the same name.
+func (l *Layout) AddManifest(desc Descriptor, refName string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	index, err := l.Index()
+	if err != nil {
+		return err
+	}
+
+	if refName != "" {
+		if desc.Annotations == nil {
+			desc.Annotations = map[string]string{}
+		}
+		desc.Annotations[AnnotationRefName] = refName
+	}
+
+	manifests := []Descriptor{}
+	for _, m := range index.Manifests {
+		if refName != "" && m.Annotations[AnnotationRefName] == refName {
+			continue
+		}
+		if refName == "" && m.Digest == desc.Digest {
+			continue
+		}
+		manifests = append(manifests, m)
+	}
+	index.Manifests = append(manifests, desc)
+
+	content, err := json.MarshalIndent(index, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(l.root, indexFile), content, 0o644)
+}
+
+// FindManifest returns the manifest of the index named refName. An empty
+// refName selects the only manifest of the index.
+func (l *Layout) FindManifest(refName string) (Descriptor, error) {
+	index, err := l.Index()
+	if err != nil {
+		return Descriptor{}, err
+	}
+
+	if refName == "" {
+		if len(index.Manifests) != 1 {
+			return Descriptor{}, fmt.Errorf("layout contains %d manifests, select one by name", len(index.Manifests))
+		}
+		return index.Manifests[0], nil
+	}
+	for _, m := range index.Manifests {
+		if m.Annotations[AnnotationRefName] == refName || m.Digest == refName {
+			return m, nil
+		}
+	}
+	return Descriptor{}, fmt.Errorf("no manifest named %s in the layout", refName)
+}
+
+// VerifyBlob checks the size and digest of a stored blob.
+func (l *Layout) VerifyBlob(desc Descriptor) error {
+	file, err := os.Open(l.BlobPath(desc.Digest))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	size, err := io.Copy(hash, file)
+	if err != nil {
+		return err
+	}
+	if size != desc.Size {
+		return fmt.Errorf("blob %s has size %d, expected %d", desc.Digest, size, desc.Size)
+	}
+	if actual := "sha256:" + hex.EncodeToString(hash.Sum(nil)); actual != desc.Digest {
+		return fmt.Errorf("blob digest mismatch: expected %s, got %s", desc.Digest, actual)
+	}
+	return nil
+}
+
+// WriteTar writes the layout as a tar archive to w.
+func (l *Layout) WriteTar(w io.Writer) error {
+	tw := tar.NewWriter(w)
+
+	err := filepath.Walk(l.root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		name, err := filepath.Rel(l.root, path)
+		if err != nil || name == "." {
+			return err
+		}
+		// Leftovers of interrupted downloads are not part of the layout.
+		if strings.HasSuffix(name, partialSuffix) {
+			return nil
+		}
+
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(name)
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		_, err = io.Copy(tw, file)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	return tw.Close()
+}
+
+// ExtractTar unpacks a tar archive of an image layout into dir.
+func ExtractTar(r io.Reader, dir string) error {
+	tr := tar.NewReader(r)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		name := filepath.Clean(filepath.FromSlash(header.Name))
+		if filepath.IsAbs(name) || name == ".." || strings.HasPrefix(name, ".."+string(filepath.Separator)) {
+			return fmt.Errorf("invalid path in archive: %s", header.Name)
+		}
+		path := filepath.Join(dir, name)
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(path, 0o755); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+				return err
+			}
+			file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+			if err != nil {
+				return err
+			}
+			if _, err := io.Copy(file, tr); err != nil {
+				file.Close()
+				return err
+			}
+			if err := file.Close(); err != nil {
+				return err
+			}
+		}
+	}
+}
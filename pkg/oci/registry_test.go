@@ -0,0 +1,359 @@
+package oci
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// testRegistry is a minimal OCI distribution registry used as a stand-in
+// for Harbor. It serves and accepts manifests and blobs per repository,
+// supports range requests, chunked uploads and cross-repository mounts, and
+// authenticates with basic credentials or bearer tokens.
+type testRegistry struct {
+	*httptest.Server
+
+	username, password string
+	// bearer makes the registry require tokens from its /token endpoint.
+	bearer bool
+	token  string
+
+	mu        sync.Mutex
+	manifests map[string]map[string]testManifest
+	blobs     map[string]map[string][]byte
+	// corrupt blobs are served with content not matching their digest.
+	corrupt map[string]bool
+	// ignoreRange makes blob downloads ignore Range headers.
+	ignoreRange bool
+
+	// mountStatus, when set, is returned for every cross-repository mount.
+	mountStatus int
+	uploads     map[string][]byte
+	nextUpload  int
+
+	tokenScopes []string
+	ranges      []string
+	// requests records the method and path of every write request.
+	requests []string
+}
+
+type testManifest struct {
+	mediaType string
+	content   []byte
+}
+
+func newTestRegistry(t *testing.T) *testRegistry {
+	r := &testRegistry{
+		username:  "admin",
+		password:  "Harbor12345",
+		token:     "registry-token",
+		manifests: map[string]map[string]testManifest{},
+		blobs:     map[string]map[string][]byte{},
+		corrupt:   map[string]bool{},
+		uploads:   map[string][]byte{},
+	}
+	r.Server = httptest.NewServer(http.HandlerFunc(r.serveHTTP))
+	t.Cleanup(r.Close)
+	return r
+}
+
+func (r *testRegistry) client() *Client {
+	return NewClient(r.URL, r.username, r.password)
+}
+
+func (r *testRegistry) serveHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.URL.Path == "/token" {
+		r.serveToken(w, req)
+		return
+	}
+
+	path := strings.TrimPrefix(req.URL.Path, "/v2/")
+	var repository, kind, reference string
+	for _, k := range []string{"/manifests/", "/blobs/"} {
+		if i := strings.LastIndex(path, k); i >= 0 {
+			repository, kind, reference = path[:i], strings.Trim(k, "/"), path[i+len(k):]
+			break
+		}
+	}
+	if repository == "" {
+		http.NotFound(w, req)
+		return
+	}
+	if !r.authorized(w, req, repository) {
+		return
+	}
+
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		r.mu.Lock()
+		r.requests = append(r.requests, req.Method+" "+req.URL.Path)
+		r.mu.Unlock()
+	}
+
+	switch {
+	case kind == "manifests" && req.Method == http.MethodPut:
+		r.receiveManifest(w, req, repository, reference)
+	case kind == "manifests":
+		r.serveManifest(w, req, repository, reference)
+	case strings.HasPrefix(reference, "uploads/"):
+		r.serveUpload(w, req, repository, strings.TrimPrefix(reference, "uploads/"))
+	default:
+		r.serveBlob(w, req, repository, reference)
+	}
+}
+
+func (r *testRegistry) serveToken(w http.ResponseWriter, req *http.Request) {
+	username, password, ok := req.BasicAuth()
+	if !ok || username != r.username || password != r.password {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	r.mu.Lock()
+	r.tokenScopes = append(r.tokenScopes, req.URL.Query()["scope"]...)
+	r.mu.Unlock()
+	_ = json.NewEncoder(w).Encode(map[string]string{"token": r.token})
+}
+
+func (r *testRegistry) authorized(w http.ResponseWriter, req *http.Request, repository string) bool {
+	if r.bearer {
+		if req.Header.Get("Authorization") == "Bearer "+r.token {
+			return true
+		}
+		w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="harbor-registry",scope="repository:%s:pull,push"`, r.URL, repository))
+		w.WriteHeader(http.StatusUnauthorized)
+		return false
+	}
+
+	username, password, ok := req.BasicAuth()
+	if ok && username == r.username && password == r.password {
+		return true
+	}
+	w.Header().Set("WWW-Authenticate", `Basic realm="harbor"`)
+	w.WriteHeader(http.StatusUnauthorized)
+	return false
+}
+
+func (r *testRegistry) serveManifest(w http.ResponseWriter, req *http.Request, repository, reference string) {
+	r.mu.Lock()
+	manifest, ok := r.manifests[repository][reference]
+	r.mu.Unlock()
+	if !ok {
+		http.NotFound(w, req)
+		return
+	}
+	w.Header().Set("Content-Type", manifest.mediaType)
+	w.Header().Set("Docker-Content-Digest", Digest(manifest.content))
+	_, _ = w.Write(manifest.content)
+}
+
+func (r *testRegistry) serveBlob(w http.ResponseWriter, req *http.Request, repository, digest string) {
+	r.mu.Lock()
+	content, ok := r.blobs[repository][digest]
+	if ok && r.corrupt[digest] {
+		content = append([]byte("corrupted "), content...)
+	}
+	rangeHeader := req.Header.Get("Range")
+	if rangeHeader != "" {
+		r.ranges = append(r.ranges, rangeHeader)
+	}
+	ignoreRange := r.ignoreRange
+	r.mu.Unlock()
+	if !ok {
+		http.NotFound(w, req)
+		return
+	}
+
+	w.Header().Set("Docker-Content-Digest", digest)
+	if req.Method == http.MethodHead {
+		w.Header().Set("Content-Length", fmt.Sprint(len(content)))
+		return
+	}
+
+	var offset int
+	if rangeHeader != "" && !ignoreRange {
+		if _, err := fmt.Sscanf(rangeHeader, "bytes=%d-", &offset); err != nil || offset > len(content) {
+			w.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+			return
+		}
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", offset, len(content)-1, len(content)))
+		w.WriteHeader(http.StatusPartialContent)
+	}
+	_, _ = w.Write(content[offset:])
+}
+
+func (r *testRegistry) receiveManifest(w http.ResponseWriter, req *http.Request, repository, reference string) {
+	content, err := io.ReadAll(req.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	manifest, err := ParseManifest(content)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	// Like a real registry, refuse manifests referencing unknown content.
+	for _, child := range manifest.Manifests {
+		if _, ok := r.manifests[repository][child.Digest]; !ok {
+			http.Error(w, "MANIFEST_BLOB_UNKNOWN "+child.Digest, http.StatusBadRequest)
+			return
+		}
+	}
+	descs := manifest.Layers
+	if manifest.Config != nil {
+		descs = append([]Descriptor{*manifest.Config}, descs...)
+	}
+	for _, d := range descs {
+		if _, ok := r.blobs[repository][d.Digest]; !ok {
+			http.Error(w, "BLOB_UNKNOWN "+d.Digest, http.StatusBadRequest)
+			return
+		}
+	}
+
+	if r.manifests[repository] == nil {
+		r.manifests[repository] = map[string]testManifest{}
+	}
+	stored := testManifest{mediaType: req.Header.Get("Content-Type"), content: content}
+	r.manifests[repository][Digest(content)] = stored
+	r.manifests[repository][reference] = stored
+	w.Header().Set("Docker-Content-Digest", Digest(content))
+	w.WriteHeader(http.StatusCreated)
+}
+
+// serveUpload implements blob upload sessions: starting one, optionally
+// mounting from another repository, chunked PATCHes, completion and
+// cancellation.
+func (r *testRegistry) serveUpload(w http.ResponseWriter, req *http.Request, repository, id string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	switch req.Method {
+	case http.MethodPost:
+		query := req.URL.Query()
+		if mount := query.Get("mount"); mount != "" {
+			if r.mountStatus != 0 {
+				http.Error(w, "mount refused", r.mountStatus)
+				return
+			}
+			if content, ok := r.blobs[query.Get("from")][mount]; ok {
+				r.storeBlob(repository, content)
+				w.WriteHeader(http.StatusCreated)
+				return
+			}
+		}
+		r.nextUpload++
+		id = fmt.Sprint(r.nextUpload)
+		r.uploads[id] = []byte{}
+		w.Header().Set("Location", fmt.Sprintf("/v2/%s/blobs/uploads/%s", repository, id))
+		w.WriteHeader(http.StatusAccepted)
+	case http.MethodPatch:
+		upload, ok := r.uploads[id]
+		if !ok {
+			http.NotFound(w, req)
+			return
+		}
+		var start, end int
+		if _, err := fmt.Sscanf(req.Header.Get("Content-Range"), "%d-%d", &start, &end); err != nil || start != len(upload) {
+			w.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+			return
+		}
+		chunk, _ := io.ReadAll(req.Body)
+		if len(chunk) != end-start+1 {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		r.uploads[id] = append(upload, chunk...)
+		w.Header().Set("Location", req.URL.Path)
+		w.WriteHeader(http.StatusAccepted)
+	case http.MethodPut:
+		upload, ok := r.uploads[id]
+		if !ok {
+			http.NotFound(w, req)
+			return
+		}
+		if Digest(upload) != req.URL.Query().Get("digest") {
+			http.Error(w, "DIGEST_INVALID", http.StatusBadRequest)
+			return
+		}
+		delete(r.uploads, id)
+		r.storeBlob(repository, upload)
+		w.WriteHeader(http.StatusCreated)
+	case http.MethodDelete:
+		delete(r.uploads, id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// storeBlob stores content in repository, the caller holds r.mu.
+func (r *testRegistry) storeBlob(repository string, content []byte) string {
+	if r.blobs[repository] == nil {
+		r.blobs[repository] = map[string][]byte{}
+	}
+	digest := Digest(content)
+	r.blobs[repository][digest] = content
+	return digest
+}
+
+// putBlob stores content in repository and returns its descriptor.
+func (r *testRegistry) putBlob(repository, mediaType string, content []byte) Descriptor {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	digest := r.storeBlob(repository, content)
+	return Descriptor{MediaType: mediaType, Digest: digest, Size: int64(len(content))}
+}
+
+// putManifest stores a manifest in repository by digest and under tag when
+// given, and returns its descriptor.
+func (r *testRegistry) putManifest(repository, tag string, manifest Manifest) Descriptor {
+	content, _ := json.Marshal(manifest)
+	desc := Descriptor{MediaType: manifest.MediaType, Digest: Digest(content), Size: int64(len(content))}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.manifests[repository] == nil {
+		r.manifests[repository] = map[string]testManifest{}
+	}
+	r.manifests[repository][desc.Digest] = testManifest{mediaType: manifest.MediaType, content: content}
+	if tag != "" {
+		r.manifests[repository][tag] = testManifest{mediaType: manifest.MediaType, content: content}
+	}
+	return desc
+}
+
+// putImage stores an image manifest with a config and the given layers.
+func (r *testRegistry) putImage(repository, tag string, platform Platform, layers ...string) Descriptor {
+	config, _ := json.Marshal(ImageConfig{OS: platform.OS, Architecture: platform.Architecture, Variant: platform.Variant})
+	manifest := Manifest{
+		SchemaVersion: 2,
+		MediaType:     MediaTypeOCIManifest,
+		Config:        ptr(r.putBlob(repository, "application/vnd.oci.image.config.v1+json", config)),
+	}
+	for _, layer := range layers {
+		manifest.Layers = append(manifest.Layers, r.putBlob(repository, "application/vnd.oci.image.layer.v1.tar+gzip", []byte(layer)))
+	}
+	desc := r.putManifest(repository, tag, manifest)
+	desc.Platform = &platform
+	return desc
+}
+
+// putIndex stores an image index referencing the given manifests.
+func (r *testRegistry) putIndex(repository, tag string, manifests ...Descriptor) Descriptor {
+	return r.putManifest(repository, tag, Manifest{
+		SchemaVersion: 2,
+		MediaType:     MediaTypeOCIIndex,
+		Manifests:     manifests,
+	})
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
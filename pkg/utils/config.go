@@ -367,6 +367,19 @@ func GetCredentials(credentialName string) (Credential, error) {
 	return Credential{}, fmt.Errorf("credential with name '%s' not found", credentialName)
 }
 
+// GetCurrentCredential returns the credential of the active context.
+func GetCurrentCredential() (Credential, error) {
+	currentConfig, err := GetCurrentHarborConfig()
+	if err != nil {
+		return Credential{}, fmt.Errorf("failed to get current Harbor configuration: %w", err)
+	}
+	if currentConfig.CurrentCredentialName == "" {
+		return Credential{}, errors.New("current-credential-name is not set in config file")
+	}
+
+	return GetCredentials(currentConfig.CurrentCredentialName)
+}
+
 func AddCredentialsToConfigFile(credential Credential, configPath string) error {
 	if _, err := os.Stat(configPath); os.IsNotExist(err) {
 		log.Fatalf("config file does not exist at %s", configPath)
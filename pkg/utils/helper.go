@@ -30,6 +30,48 @@ func FormatCreatedTime(timestamp string) (string, error) {
 	}
 }
 
+// ParseAge parses a duration such as "30d", "2w" or "12h". Days and weeks
+// are accepted in addition to the units understood by time.ParseDuration.
+func ParseAge(age string) (time.Duration, error) {
+	age = strings.TrimSpace(age)
+	units := map[string]time.Duration{
+		"d": 24 * time.Hour,
+		"w": 7 * 24 * time.Hour,
+	}
+	for suffix, unit := range units {
+		if strings.HasSuffix(age, suffix) {
+			n, err := strconv.Atoi(strings.TrimSuffix(age, suffix))
+			if err != nil || n < 0 {
+				return 0, fmt.Errorf("invalid age: %s", age)
+			}
+			return time.Duration(n) * unit, nil
+		}
+	}
+
+	d, err := time.ParseDuration(age)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("invalid age: %s", age)
+	}
+	return d, nil
+}
+
+// ParseTimeOrAge parses an absolute time given as RFC3339 or a date
+// (2006-01-02), or an age such as "7d" counted back from now.
+func ParseTimeOrAge(value string, now time.Time) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02", value, time.Local); err == nil {
+		return t, nil
+	}
+	age, err := ParseAge(value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time: %s, use a date, RFC3339 time or an age such as 7d", value)
+	}
+	return now.Add(-age), nil
+}
+
 func FormatUrl(url string) string {
 	// Check if URL starts with "http://" or "https://"
 	if !strings.HasPrefix(url, "http://") && !strings.HasPrefix(url, "https://") {
@@ -44,6 +86,42 @@ func FormatSize(size int64) string {
 	return fmt.Sprintf("%.2fMiB", mbSize)
 }
 
+// ParseStorageSize parses a storage size such as "500MiB", "10GB" or "1T"
+// into bytes. Units are powers of 1024, a bare number is taken as bytes and
+// -1 stands for unlimited.
+func ParseStorageSize(size string) (int64, error) {
+	size = strings.TrimSpace(size)
+	if size == "-1" {
+		return -1, nil
+	}
+
+	units := []struct {
+		suffix string
+		factor int64
+	}{
+		{"TIB", 1 << 40}, {"TB", 1 << 40}, {"T", 1 << 40},
+		{"GIB", 1 << 30}, {"GB", 1 << 30}, {"G", 1 << 30},
+		{"MIB", 1 << 20}, {"MB", 1 << 20}, {"M", 1 << 20},
+		{"KIB", 1 << 10}, {"KB", 1 << 10}, {"K", 1 << 10},
+		{"B", 1},
+	}
+	upper := strings.ToUpper(size)
+	factor := int64(1)
+	for _, unit := range units {
+		if strings.HasSuffix(upper, unit.suffix) {
+			upper = strings.TrimSpace(strings.TrimSuffix(upper, unit.suffix))
+			factor = unit.factor
+			break
+		}
+	}
+
+	n, err := strconv.ParseFloat(upper, 64)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid storage size: %s", size)
+	}
+	return int64(n * float64(factor)), nil
+}
+
 // ValidateUserName checks if the username is valid by length and allowed characters.
 func ValidateUserName(username string) bool {
 	username = strings.TrimSpace(username)
@@ -140,3 +218,103 @@ func PrintFormat[T any](resp T, format string) error {
 	}
 	return fmt.Errorf("unable to output in the specified '%s' format", format)
 }
+
+// IsDigest reports whether the reference is a digest rather than a tag.
+func IsDigest(reference string) bool {
+	return strings.HasPrefix(reference, "sha256:")
+}
+
+// FormatArtifactReference returns the reference in the "project/repository:tag"
+// or "project/repository@digest" form understood by the Harbor API.
+func FormatArtifactReference(projectName, repoName, reference string) string {
+	if IsDigest(reference) {
+		return fmt.Sprintf("%s/%s@%s", projectName, repoName, reference)
+	}
+	return fmt.Sprintf("%s/%s:%s", projectName, repoName, reference)
+}
+
+// ShortDigest truncates a digest for display in tables.
+func ShortDigest(digest string) string {
+	if len(digest) > 16 {
+		return digest[:16]
+	}
+	return digest
+}
+
+// FormatPlatform returns a platform in the os/arch[/variant] form.
+func FormatPlatform(os, arch, variant string) string {
+	platform := os + "/" + arch
+	if variant != "" {
+		platform += "/" + variant
+	}
+	return platform
+}
+
+// ParsePlatform splits a platform given as os/arch[/variant].
+func ParsePlatform(platform string) (string, string, string, error) {
+	parts := strings.Split(platform, "/")
+	if len(parts) < 2 || len(parts) > 3 || parts[0] == "" || parts[1] == "" {
+		return "", "", "", fmt.Errorf("invalid platform %q, must be os/arch[/variant]", platform)
+	}
+	if len(parts) == 2 {
+		return parts[0], parts[1], "", nil
+	}
+	return parts[0], parts[1], parts[2], nil
+}
+
+// MatchDoublestar reports whether name matches a doublestar pattern as used
+// by Harbor rules: "**" matches across "/", "*" and "?" do not, "{a,b}"
+// matches either alternative and "[...]" a character class.
+func MatchDoublestar(pattern, name string) bool {
+	var expr strings.Builder
+	expr.WriteString("^")
+	braces := 0
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		switch {
+		case strings.HasPrefix(pattern[i:], "**/"):
+			// Like doublestar, "**/" also matches no directory at all.
+			expr.WriteString("(?:.*/)?")
+			i += 2
+		case c == '*' && i+1 < len(pattern) && pattern[i+1] == '*':
+			expr.WriteString(".*")
+			i++
+		case c == '*':
+			expr.WriteString("[^/]*")
+		case c == '?':
+			expr.WriteString("[^/]")
+		case c == '{':
+			expr.WriteString("(?:")
+			braces++
+		case c == '}' && braces > 0:
+			expr.WriteString(")")
+			braces--
+		case c == ',' && braces > 0:
+			expr.WriteString("|")
+		case c == '[':
+			end := strings.IndexByte(pattern[i+1:], ']')
+			if end < 0 {
+				expr.WriteString(`\[`)
+				continue
+			}
+			class := pattern[i+1 : i+1+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			expr.WriteString("[" + class + "]")
+			i += end + 1
+		default:
+			expr.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	if braces > 0 {
+		return false
+	}
+	expr.WriteString("$")
+
+	re, err := regexp.Compile(expr.String())
+	if err != nil {
+		return false
+	}
+	return re.MatchString(name)
+}
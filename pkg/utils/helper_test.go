@@ -0,0 +1,148 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAge(t *testing.T) {
+	tests := []struct {
+		age     string
+		want    time.Duration
+		wantErr bool
+	}{
+		{age: "30d", want: 30 * 24 * time.Hour},
+		{age: "2w", want: 14 * 24 * time.Hour},
+		{age: "12h", want: 12 * time.Hour},
+		{age: " 90m ", want: 90 * time.Minute},
+		{age: "0d", want: 0},
+		{age: "-1d", wantErr: true},
+		{age: "-5h", wantErr: true},
+		{age: "d", wantErr: true},
+		{age: "1.5d", wantErr: true},
+		{age: "soon", wantErr: true},
+		{age: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.age, func(t *testing.T) {
+			got, err := ParseAge(tt.age)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseTimeOrAge(t *testing.T) {
+	now := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		value   string
+		want    time.Time
+		wantErr bool
+	}{
+		{value: "2024-05-01T08:30:00Z", want: time.Date(2024, 5, 1, 8, 30, 0, 0, time.UTC)},
+		{value: "2024-05-01", want: time.Date(2024, 5, 1, 0, 0, 0, 0, time.Local)},
+		{value: "7d", want: now.Add(-7 * 24 * time.Hour)},
+		{value: "yesterday", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseTimeOrAge(tt.value, now)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.True(t, tt.want.Equal(got), "got %s, want %s", got, tt.want)
+		})
+	}
+}
+
+func TestParseStorageSize(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    int64
+		wantErr bool
+	}{
+		{input: "-1", want: -1},
+		{input: "0", want: 0},
+		{input: "1024", want: 1024},
+		{input: "512B", want: 512},
+		{input: "1K", want: 1 << 10},
+		{input: "1KiB", want: 1 << 10},
+		{input: "500MiB", want: 500 << 20},
+		{input: "500mb", want: 500 << 20},
+		{input: "10GB", want: 10 << 30},
+		{input: " 10 GiB ", want: 10 << 30},
+		{input: "1.5G", want: 3 << 29},
+		{input: "953.67MiB", want: 999995473},
+		{input: "2T", want: 2 << 40},
+		{input: "", wantErr: true},
+		{input: "GiB", wantErr: true},
+		{input: "-5GiB", wantErr: true},
+		{input: "ten", wantErr: true},
+		{input: "10PiB", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseStorageSize(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMatchDoublestar(t *testing.T) {
+	tests := []struct {
+		pattern string
+		name    string
+		want    bool
+	}{
+		{pattern: "**", name: "library/nginx", want: true},
+		{pattern: "**", name: "", want: true},
+		{pattern: "*", name: "nginx", want: true},
+		{pattern: "*", name: "library/nginx", want: false},
+		{pattern: "library/*", name: "library/nginx", want: true},
+		{pattern: "library/*", name: "library/team/nginx", want: false},
+		{pattern: "library/**", name: "library/team/nginx", want: true},
+		{pattern: "**/nginx", name: "library/team/nginx", want: true},
+		{pattern: "**/nginx", name: "nginx", want: true},
+		{pattern: "team-a/**/app", name: "team-a/app", want: true},
+		{pattern: "team-a/**/app", name: "team-a/x/y/app", want: true},
+		{pattern: "team-a/**/app", name: "team-a/webapp", want: false},
+		{pattern: "v1.?", name: "v1.2", want: true},
+		{pattern: "v1.?", name: "v1.20", want: false},
+		{pattern: "v1.?", name: "v1/2", want: false},
+		{pattern: "v1.*", name: "v112", want: false},
+		{pattern: "{alpine,debian}", name: "debian", want: true},
+		{pattern: "{alpine,debian}", name: "ubuntu", want: false},
+		{pattern: "{alpine,debian}-*", name: "alpine-3.20", want: true},
+		{pattern: "{release-*,v*}", name: "release-1.0", want: true},
+		{pattern: "{alpine,debian", name: "alpine", want: false},
+		{pattern: "v[0-9]*", name: "v1.2", want: true},
+		{pattern: "v[0-9]*", name: "vx", want: false},
+		{pattern: "v[!0-9]*", name: "vx", want: true},
+		{pattern: "v[!0-9]*", name: "v1", want: false},
+		{pattern: "v[0-9", name: "v[0-9", want: true},
+		{pattern: "a+b(c)", name: "a+b(c)", want: true},
+		{pattern: "latest", name: "latest-dev", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, MatchDoublestar(tt.pattern, tt.name))
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package accessories
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	"github.com/goharbor/harbor-cli/pkg/views/base/tablelist"
+)
+
+var columns = []table.Column{
+	{Title: "Type", Width: 20},
+	{Title: "Digest", Width: 20},
+	{Title: "Size", Width: 12},
+	{Title: "Subject", Width: 30},
+	{Title: "Creation Time", Width: 15},
+}
+
+func ListAccessories(accessories []*models.Accessory) {
+	var rows []table.Row
+	for _, accessory := range accessories {
+		createdTime, _ := utils.FormatCreatedTime(accessory.CreationTime.String())
+		rows = append(rows, table.Row{
+			accessory.Type,
+			utils.ShortDigest(accessory.Digest),
+			utils.FormatSize(accessory.Size),
+			fmt.Sprintf("%s@%s", accessory.SubjectArtifactRepo, utils.ShortDigest(accessory.SubjectArtifactDigest)),
+			createdTime,
+		})
+	}
+
+	m := tablelist.NewModel(columns, rows, len(rows))
+
+	if _, err := tea.NewProgram(m).Run(); err != nil {
+		fmt.Println("Error running program:", err)
+		os.Exit(1)
+	}
+}
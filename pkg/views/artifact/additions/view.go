@@ -0,0 +1,100 @@
+package additions
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/glamour"
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	"github.com/goharbor/harbor-cli/pkg/views/base/tablelist"
+)
+
+// BuildHistoryEntry is a step of the image build history.
+type BuildHistoryEntry struct {
+	Created    string `json:"created" yaml:"created"`
+	CreatedBy  string `json:"created_by" yaml:"created_by"`
+	Comment    string `json:"comment,omitempty" yaml:"comment,omitempty"`
+	EmptyLayer bool   `json:"empty_layer,omitempty" yaml:"empty_layer,omitempty"`
+}
+
+// Dependency is a dependency of a Helm chart.
+type Dependency struct {
+	Name       string `json:"name" yaml:"name"`
+	Version    string `json:"version" yaml:"version"`
+	Repository string `json:"repository" yaml:"repository"`
+}
+
+var historyColumns = []table.Column{
+	{Title: "Step", Width: 6},
+	{Title: "Created", Width: 15},
+	{Title: "Created By", Width: 70},
+	{Title: "Empty Layer", Width: 12},
+}
+
+var dependencyColumns = []table.Column{
+	{Title: "Name", Width: 20},
+	{Title: "Version", Width: 12},
+	{Title: "Repository", Width: 50},
+}
+
+func ViewBuildHistory(history []BuildHistoryEntry) {
+	var rows []table.Row
+	for i, entry := range history {
+		created, err := utils.FormatCreatedTime(entry.Created)
+		if err != nil {
+			created = entry.Created
+		}
+		createdBy := strings.Join(strings.Fields(entry.CreatedBy), " ")
+		createdBy = strings.TrimPrefix(createdBy, "/bin/sh -c #(nop) ")
+		rows = append(rows, table.Row{
+			strconv.Itoa(i + 1),
+			created,
+			createdBy,
+			strconv.FormatBool(entry.EmptyLayer),
+		})
+	}
+
+	m := tablelist.NewModel(historyColumns, rows, len(rows))
+
+	if _, err := tea.NewProgram(m).Run(); err != nil {
+		fmt.Println("Error running program:", err)
+		os.Exit(1)
+	}
+}
+
+func ViewDependencies(dependencies []Dependency) {
+	var rows []table.Row
+	for _, dependency := range dependencies {
+		rows = append(rows, table.Row{
+			dependency.Name,
+			dependency.Version,
+			dependency.Repository,
+		})
+	}
+
+	m := tablelist.NewModel(dependencyColumns, rows, len(rows))
+
+	if _, err := tea.NewProgram(m).Run(); err != nil {
+		fmt.Println("Error running program:", err)
+		os.Exit(1)
+	}
+}
+
+// ViewMarkdown renders markdown such as a chart README in the terminal.
+func ViewMarkdown(content string) {
+	out, err := glamour.Render(content, "auto")
+	if err != nil {
+		fmt.Println(content)
+		return
+	}
+	fmt.Print(out)
+}
+
+// ViewValues renders the values.yaml of a chart with syntax highlighting.
+func ViewValues(content string) {
+	ViewMarkdown("```yaml\n" + content + "\n```\n")
+}
@@ -0,0 +1,156 @@
+package diff
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/goharbor/harbor-cli/pkg/api"
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	"github.com/goharbor/harbor-cli/pkg/views"
+)
+
+// Side identifies one of the compared artifacts.
+type Side struct {
+	Reference string `json:"reference"`
+	Digest    string `json:"digest"`
+	MediaType string `json:"media_type"`
+	Size      int64  `json:"size"`
+}
+
+// Layer is a layer of an image manifest.
+type Layer struct {
+	Digest string `json:"digest"`
+	Size   int64  `json:"size"`
+}
+
+// Change is a difference in a single field. From is empty for additions
+// and To is empty for removals.
+type Change struct {
+	Field string `json:"field"`
+	From  string `json:"from,omitempty"`
+	To    string `json:"to,omitempty"`
+}
+
+type LayerDiff struct {
+	Added     []Layer `json:"added"`
+	Removed   []Layer `json:"removed"`
+	Unchanged int     `json:"unchanged"`
+}
+
+type HistoryDiff struct {
+	Added   []string `json:"added"`
+	Removed []string `json:"removed"`
+}
+
+type VulnerabilityDiff struct {
+	Added   []api.Vulnerability `json:"added"`
+	Removed []api.Vulnerability `json:"removed"`
+	Fixed   []api.Vulnerability `json:"fixed"`
+}
+
+// Report is the difference between two artifacts.
+type Report struct {
+	From            Side               `json:"from"`
+	To              Side               `json:"to"`
+	Manifests       []Change           `json:"manifests,omitempty"`
+	Layers          LayerDiff          `json:"layers"`
+	Config          []Change           `json:"config"`
+	History         HistoryDiff        `json:"history"`
+	Vulnerabilities *VulnerabilityDiff `json:"vulnerabilities,omitempty"`
+}
+
+func ViewReport(r Report) {
+	fmt.Printf("--- %s (%s)\n", r.From.Reference, utils.ShortDigest(r.From.Digest))
+	fmt.Printf("+++ %s (%s)\n", r.To.Reference, utils.ShortDigest(r.To.Digest))
+	if r.From.Digest == r.To.Digest {
+		fmt.Println("\nBoth references point to the same artifact")
+		return
+	}
+
+	section("Manifest")
+	if r.From.MediaType != r.To.MediaType {
+		changed("Media Type", r.From.MediaType, r.To.MediaType)
+	}
+	fmt.Printf("  Size: %s -> %s (%s)\n", utils.FormatSize(r.From.Size), utils.FormatSize(r.To.Size), sizeDelta(r.To.Size-r.From.Size))
+	for _, c := range r.Manifests {
+		printChange(c)
+	}
+
+	section("Layers")
+	for _, l := range r.Layers.Removed {
+		removed(fmt.Sprintf("%s (%s)", l.Digest, utils.FormatSize(l.Size)))
+	}
+	for _, l := range r.Layers.Added {
+		added(fmt.Sprintf("%s (%s)", l.Digest, utils.FormatSize(l.Size)))
+	}
+	fmt.Printf("  %d layer(s) unchanged\n", r.Layers.Unchanged)
+
+	section("Config")
+	if len(r.Config) == 0 {
+		fmt.Println("  no changes")
+	}
+	for _, c := range r.Config {
+		printChange(c)
+	}
+
+	section("Build History")
+	if len(r.History.Added) == 0 && len(r.History.Removed) == 0 {
+		fmt.Println("  no changes")
+	}
+	for _, step := range r.History.Removed {
+		removed(step)
+	}
+	for _, step := range r.History.Added {
+		added(step)
+	}
+
+	section("Vulnerabilities")
+	if r.Vulnerabilities == nil {
+		fmt.Println("  not available, both artifacts must be scanned")
+		return
+	}
+	fmt.Printf("  %d added, %d fixed, %d removed\n", len(r.Vulnerabilities.Added), len(r.Vulnerabilities.Fixed), len(r.Vulnerabilities.Removed))
+	for _, v := range r.Vulnerabilities.Added {
+		added(fmt.Sprintf("%s %s %s@%s", v.Severity, v.ID, v.Package, v.Version))
+	}
+	for _, v := range r.Vulnerabilities.Fixed {
+		fmt.Printf("  ✓ %s %s %s@%s\n", v.Severity, v.ID, v.Package, v.Version)
+	}
+	for _, v := range r.Vulnerabilities.Removed {
+		removed(fmt.Sprintf("%s %s %s@%s", v.Severity, v.ID, v.Package, v.Version))
+	}
+}
+
+func section(title string) {
+	fmt.Printf("\n%s\n", views.TitleStyle.Bold(true).Render(title))
+}
+
+func printChange(c Change) {
+	switch {
+	case c.From == "":
+		added(fmt.Sprintf("%s: %s", c.Field, c.To))
+	case c.To == "":
+		removed(fmt.Sprintf("%s: %s", c.Field, c.From))
+	default:
+		changed(c.Field, c.From, c.To)
+	}
+}
+
+func added(s string) {
+	fmt.Println(views.GreenStyle.Render("  + " + s))
+}
+
+func removed(s string) {
+	fmt.Println(views.RedStyle.Render("  - " + s))
+}
+
+func changed(field, from, to string) {
+	fmt.Printf("  ~ %s: %s -> %s\n", field, from, to)
+}
+
+func sizeDelta(delta int64) string {
+	if delta < 0 {
+		return "-" + utils.FormatSize(-delta)
+	}
+	return "+" + strings.TrimSpace(utils.FormatSize(delta))
+}
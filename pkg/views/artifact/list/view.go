@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/charmbracelet/bubbles/table"
 	tea "github.com/charmbracelet/bubbletea"
@@ -15,35 +17,105 @@ import (
 var columns = []table.Column{
 	{Title: "ID", Width: 6},
 	{Title: "Artifact Digest", Width: 20},
-	{Title: "Type", Width: 12},
-	{Title: "Size", Width: 12},
-	{Title: "Vulnerabilities", Width: 15},
+	{Title: "Tags", Width: 20},
+	{Title: "Type", Width: 8},
+	{Title: "Size", Width: 10},
 	{Title: "Push Time", Width: 12},
+	{Title: "Pull Time", Width: 12},
 }
 
-func ListArtifacts(artifacts []*models.Artifact) {
+var severityColumns = []table.Column{
+	{Title: "Critical", Width: 8},
+	{Title: "High", Width: 8},
+	{Title: "Medium", Width: 8},
+	{Title: "Low", Width: 8},
+}
+
+var severities = []string{"Critical", "High", "Medium", "Low"}
+
+var accessoriesColumn = table.Column{Title: "Accessories", Width: 30}
+
+// ListOptions selects the optional columns of the artifact list.
+type ListOptions struct {
+	WithAccessories  bool
+	WithScanOverview bool
+}
+
+func ListArtifacts(artifacts []*models.Artifact, opts ListOptions) {
+	cols := columns[:len(columns):len(columns)]
+	if opts.WithScanOverview {
+		cols = append(cols, severityColumns...)
+	}
+	if opts.WithAccessories {
+		cols = append(cols, accessoriesColumn)
+	}
+
 	var rows []table.Row
 	for _, artifact := range artifacts {
 		pushTime, _ := utils.FormatCreatedTime(artifact.PushTime.String())
+		pullTime := "Never"
+		if !time.Time(artifact.PullTime).IsZero() {
+			pullTime, _ = utils.FormatCreatedTime(artifact.PullTime.String())
+		}
 		artifactSize := utils.FormatSize(artifact.Size)
-		var totalVulnerabilities int64
-		for _, scan := range artifact.ScanOverview {
-			totalVulnerabilities += scan.Summary.Total
+
+		var tags []string
+		for _, tag := range artifact.Tags {
+			tags = append(tags, tag.Name)
 		}
-		rows = append(rows, table.Row{
+
+		row := table.Row{
 			strconv.FormatInt(int64(artifact.ID), 10),
 			artifact.Digest[:16],
+			strings.Join(tags, ","),
 			artifact.Type,
 			artifactSize,
-			strconv.FormatInt(totalVulnerabilities, 10),
 			pushTime,
-		})
+			pullTime,
+		}
+		if opts.WithScanOverview {
+			row = append(row, severityCounts(artifact)...)
+		}
+		if opts.WithAccessories {
+			var types []string
+			for _, accessory := range artifact.Accessories {
+				types = append(types, accessory.Type)
+			}
+			row = append(row, strings.Join(types, ","))
+		}
+		rows = append(rows, row)
 	}
 
-	m := tablelist.NewModel(columns, rows, len(rows))
+	m := tablelist.NewModel(cols, rows, len(rows))
 
 	if _, err := tea.NewProgram(m).Run(); err != nil {
 		fmt.Println("Error running program:", err)
 		os.Exit(1)
 	}
 }
+
+// severityCounts returns the number of vulnerabilities per severity, or
+// dashes when the artifact has not been scanned.
+func severityCounts(artifact *models.Artifact) []string {
+	counts := make([]int64, len(severities))
+	scanned := false
+	for _, scan := range artifact.ScanOverview {
+		if scan.Summary == nil {
+			continue
+		}
+		scanned = true
+		for i, severity := range severities {
+			counts[i] += scan.Summary.Summary[severity]
+		}
+	}
+
+	cells := make([]string, len(severities))
+	for i := range severities {
+		if scanned {
+			cells[i] = strconv.FormatInt(counts[i], 10)
+		} else {
+			cells[i] = "-"
+		}
+	}
+	return cells
+}
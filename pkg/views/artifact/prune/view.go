@@ -0,0 +1,47 @@
+package prune
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	"github.com/goharbor/harbor-cli/pkg/views/base/tablelist"
+)
+
+var columns = []table.Column{
+	{Title: "Artifact Digest", Width: 20},
+	{Title: "Tags", Width: 24},
+	{Title: "Size", Width: 12},
+	{Title: "Push Time", Width: 12},
+}
+
+func ListPrunePlan(artifacts []*models.Artifact) {
+	var rows []table.Row
+	var totalSize int64
+	for _, artifact := range artifacts {
+		pushTime, _ := utils.FormatCreatedTime(artifact.PushTime.String())
+		var tags []string
+		for _, tag := range artifact.Tags {
+			tags = append(tags, tag.Name)
+		}
+		totalSize += artifact.Size
+		rows = append(rows, table.Row{
+			utils.ShortDigest(artifact.Digest),
+			strings.Join(tags, ","),
+			utils.FormatSize(artifact.Size),
+			pushTime,
+		})
+	}
+
+	m := tablelist.NewModel(columns, rows, len(rows))
+
+	if _, err := tea.NewProgram(m).Run(); err != nil {
+		fmt.Println("Error running program:", err)
+		os.Exit(1)
+	}
+	fmt.Printf("%d artifact(s) to delete, %s in total\n", len(artifacts), utils.FormatSize(totalSize))
+}
@@ -0,0 +1,51 @@
+package verify
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	"github.com/goharbor/harbor-cli/pkg/views/base/tablelist"
+)
+
+// Result is the outcome of verifying one signature of an artifact.
+type Result struct {
+	Signature       string `json:"signature"`
+	Digest          string `json:"digest"`
+	Signer          string `json:"signer"`
+	DockerReference string `json:"docker_reference,omitempty"`
+	Verified        bool   `json:"verified"`
+	Error           string `json:"error,omitempty"`
+}
+
+var columns = []table.Column{
+	{Title: "Signature", Width: 20},
+	{Title: "Artifact Digest", Width: 20},
+	{Title: "Signer", Width: 30},
+	{Title: "Result", Width: 40},
+}
+
+func ListResults(results []Result) {
+	var rows []table.Row
+	for _, result := range results {
+		status := "Verified"
+		if !result.Verified {
+			status = "Failed: " + result.Error
+		}
+		rows = append(rows, table.Row{
+			utils.ShortDigest(result.Signature),
+			utils.ShortDigest(result.Digest),
+			result.Signer,
+			status,
+		})
+	}
+
+	m := tablelist.NewModel(columns, rows, len(rows))
+
+	if _, err := tea.NewProgram(m).Run(); err != nil {
+		fmt.Println("Error running program:", err)
+		os.Exit(1)
+	}
+}
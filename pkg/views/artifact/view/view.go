@@ -46,3 +46,59 @@ func ViewArtifact(artifact *models.Artifact) {
 		os.Exit(1)
 	}
 }
+
+var platformColumns = []table.Column{
+	{Title: "Platform", Width: 16},
+	{Title: "Digest", Width: 20},
+	{Title: "Size", Width: 10},
+	{Title: "Scan Status", Width: 12},
+	{Title: "Severity", Width: 10},
+	{Title: "Vulnerabilities", Width: 15},
+}
+
+// ViewIndex shows an image index followed by the manifest of every platform.
+func ViewIndex(index *models.Artifact, children []*models.Artifact) {
+	pushTime, _ := utils.FormatCreatedTime(index.PushTime.String())
+	fmt.Printf("Index %s (%s), %d platforms, pushed %s\n", utils.ShortDigest(index.Digest), utils.FormatSize(index.Size), len(index.References), pushTime)
+
+	platforms := map[string]string{}
+	for _, ref := range index.References {
+		if ref.Platform != nil {
+			platforms[ref.ChildDigest] = utils.FormatPlatform(ref.Platform.Os, ref.Platform.Architecture, ref.Platform.Variant)
+		}
+	}
+
+	var rows []table.Row
+	for _, child := range children {
+		platform, ok := platforms[child.Digest]
+		if !ok {
+			platform = "unknown"
+		}
+		status, severity := "Not Scanned", "-"
+		var totalVulnerabilities int64
+		for _, scan := range child.ScanOverview {
+			status = scan.ScanStatus
+			if scan.Severity != "" {
+				severity = scan.Severity
+			}
+			if scan.Summary != nil {
+				totalVulnerabilities += scan.Summary.Total
+			}
+		}
+		rows = append(rows, table.Row{
+			platform,
+			utils.ShortDigest(child.Digest),
+			utils.FormatSize(child.Size),
+			status,
+			severity,
+			strconv.FormatInt(totalVulnerabilities, 10),
+		})
+	}
+
+	m := tablelist.NewModel(platformColumns, rows, len(rows))
+
+	if _, err := tea.NewProgram(m).Run(); err != nil {
+		fmt.Println("Error running program:", err)
+		os.Exit(1)
+	}
+}
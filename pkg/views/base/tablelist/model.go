@@ -31,6 +31,9 @@ func NewModel(columns []table.Column, rows []table.Row, height int) Model {
 		Background(s.Cell.GetBackground()).
 		Bold(false)
 	t.SetStyles(s)
+	// bubbles subtracts the header from the height it is given, add it back
+	// so that height rows are shown.
+	t.SetHeight(height + lipgloss.Height(s.Header.Render()))
 
 	return Model{Table: t}
 }
@@ -19,3 +19,18 @@ func ConfirmElevation() (bool, error) {
 
 	return confirm, nil
 }
+
+func ConfirmDeletion(title string) (bool, error) {
+	var confirm bool
+
+	err := huh.NewConfirm().
+		Title(title).
+		Affirmative("Yes").
+		Negative("No").
+		Value(&confirm).Run()
+	if err != nil {
+		return false, err
+	}
+
+	return confirm, nil
+}
@@ -0,0 +1,44 @@
+package view
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/views/base/tablelist"
+)
+
+var columns = []table.Column{
+	{Title: "CVE ID", Width: 24},
+}
+
+func ViewAllowlist(scope string, allowlist *models.CVEAllowlist) {
+	fmt.Printf("%s, %d CVE(s), expires: %s\n", scope, len(allowlist.Items), Expiry(allowlist))
+
+	var rows []table.Row
+	for _, item := range allowlist.Items {
+		rows = append(rows, table.Row{item.CVEID})
+	}
+
+	m := tablelist.NewModel(columns, rows, len(rows))
+	if _, err := tea.NewProgram(m).Run(); err != nil {
+		fmt.Println("Error running program:", err)
+		os.Exit(1)
+	}
+}
+
+// Expiry returns the expiry date of the allowlist, marking it when it has
+// already passed.
+func Expiry(allowlist *models.CVEAllowlist) string {
+	if allowlist.ExpiresAt == nil || *allowlist.ExpiresAt <= 0 {
+		return "never"
+	}
+	expires := time.Unix(*allowlist.ExpiresAt, 0)
+	if expires.Before(time.Now()) {
+		return expires.Format("2006-01-02") + " (expired)"
+	}
+	return expires.Format("2006-01-02")
+}
@@ -0,0 +1,108 @@
+package history
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	"github.com/goharbor/harbor-cli/pkg/views/base/tablelist"
+)
+
+var columns = []table.Column{
+	{Title: "ID", Width: 6},
+	{Title: "Trigger", Width: 10},
+	{Title: "Dry Run", Width: 8},
+	{Title: "Status", Width: 10},
+	{Title: "Details", Width: 50},
+	{Title: "Creation Time", Width: 16},
+	{Title: "Update Time", Width: 16},
+}
+
+// Parameters are the job parameters of a GC job, to which Harbor adds what
+// the job freed once it completes.
+type Parameters struct {
+	DeleteUntagged  bool   `json:"delete_untagged"`
+	DryRun          bool   `json:"dry_run"`
+	Workers         int    `json:"workers"`
+	FreedSpace      *int64 `json:"freed_space"`
+	PurgedBlobs     *int64 `json:"purged_blobs"`
+	PurgedManifests *int64 `json:"purged_manifests"`
+}
+
+func ParseParameters(job *models.GCHistory) Parameters {
+	var parameters Parameters
+	_ = json.Unmarshal([]byte(job.JobParameters), &parameters)
+	return parameters
+}
+
+func ListHistory(jobs []*models.GCHistory) {
+	var rows []table.Row
+	for _, job := range jobs {
+		createdTime, _ := utils.FormatCreatedTime(job.CreationTime.String())
+		updatedTime, _ := utils.FormatCreatedTime(job.UpdateTime.String())
+		dryRun := "No"
+		if ParseParameters(job).DryRun {
+			dryRun = "Yes"
+		}
+		rows = append(rows, table.Row{
+			fmt.Sprintf("%d", job.ID),
+			Trigger(job),
+			dryRun,
+			job.JobStatus,
+			Details(job),
+			createdTime,
+			updatedTime,
+		})
+	}
+
+	m := tablelist.NewModel(columns, rows, len(rows))
+
+	if _, err := tea.NewProgram(m).Run(); err != nil {
+		fmt.Println("Error running program:", err)
+		os.Exit(1)
+	}
+}
+
+func Trigger(job *models.GCHistory) string {
+	if job.Schedule == nil || job.Schedule.Type == "" {
+		return "-"
+	}
+	return job.Schedule.Type
+}
+
+// Details summarizes what a GC job freed, or would free on a dry run.
+func Details(job *models.GCHistory) string {
+	parameters := ParseParameters(job)
+	if parameters.PurgedBlobs == nil && parameters.FreedSpace == nil {
+		return "-"
+	}
+
+	var blobs, manifests, space int64
+	if parameters.PurgedBlobs != nil {
+		blobs = *parameters.PurgedBlobs
+	}
+	if parameters.PurgedManifests != nil {
+		manifests = *parameters.PurgedManifests
+	}
+	if parameters.FreedSpace != nil {
+		space = *parameters.FreedSpace
+	}
+	if parameters.DryRun {
+		return fmt.Sprintf("%d blobs and %d manifests eligible, %s to free", blobs, manifests, utils.FormatSize(space))
+	}
+	return fmt.Sprintf("%d blobs and %d manifests deleted, %s freed", blobs, manifests, utils.FormatSize(space))
+}
+
+// Running reports whether a GC job has yet to complete.
+func Running(job *models.GCHistory) bool {
+	switch strings.ToLower(job.JobStatus) {
+	case "pending", "running", "scheduled":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,49 @@
+package schedule
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/views/base/tablelist"
+	"github.com/goharbor/harbor-cli/pkg/views/gc/history"
+)
+
+var columns = []table.Column{
+	{Title: "Setting", Width: 18},
+	{Title: "Value", Width: 30},
+}
+
+func ViewSchedule(job *models.GCHistory) {
+	if job.Schedule == nil || job.Schedule.Type == "" || job.Schedule.Type == "None" {
+		fmt.Println("GC is not scheduled")
+		return
+	}
+
+	next := "-"
+	if !time.Time(job.Schedule.NextScheduledTime).IsZero() {
+		next = time.Time(job.Schedule.NextScheduledTime).Local().Format("2006-01-02 15:04 MST")
+	}
+	parameters := history.ParseParameters(job)
+	deleteUntagged := "No"
+	if parameters.DeleteUntagged {
+		deleteUntagged = "Yes"
+	}
+
+	rows := []table.Row{
+		{"Type", job.Schedule.Type},
+		{"Cron", job.Schedule.Cron},
+		{"Next run", next},
+		{"Delete untagged", deleteUntagged},
+		{"Workers", fmt.Sprintf("%d", parameters.Workers)},
+	}
+
+	m := tablelist.NewModel(columns, rows, len(rows))
+	if _, err := tea.NewProgram(m).Run(); err != nil {
+		fmt.Println("Error running program:", err)
+		os.Exit(1)
+	}
+}
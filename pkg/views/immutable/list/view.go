@@ -0,0 +1,66 @@
+package list
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/views/base/tablelist"
+)
+
+var columns = []table.Column{
+	{Title: "ID", Width: 6},
+	{Title: "Status", Width: 8},
+	{Title: "Repositories", Width: 30},
+	{Title: "Tags", Width: 30},
+}
+
+func ListRules(rules []*models.ImmutableRule) {
+	var rows []table.Row
+	for _, rule := range rules {
+		status := "enabled"
+		if rule.Disabled {
+			status = "disabled"
+		}
+		rows = append(rows, table.Row{
+			fmt.Sprintf("%d", rule.ID),
+			status,
+			Repositories(rule),
+			Tags(rule),
+		})
+	}
+
+	m := tablelist.NewModel(columns, rows, len(rows))
+	if _, err := tea.NewProgram(m).Run(); err != nil {
+		fmt.Println("Error running program:", err)
+		os.Exit(1)
+	}
+}
+
+// Repositories describes the repositories a rule applies to.
+func Repositories(rule *models.ImmutableRule) string {
+	var parts []string
+	for _, selector := range rule.ScopeSelectors["repository"] {
+		parts = append(parts, describeSelector(selector))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Tags describes the tags a rule applies to.
+func Tags(rule *models.ImmutableRule) string {
+	var parts []string
+	for _, selector := range rule.TagSelectors {
+		parts = append(parts, describeSelector(*selector))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func describeSelector(selector models.ImmutableSelector) string {
+	if selector.Decoration == "excludes" || selector.Decoration == "repoExcludes" {
+		return "excluding " + selector.Pattern
+	}
+	return "matching " + selector.Pattern
+}
@@ -0,0 +1,40 @@
+package list
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/views/base/tablelist"
+)
+
+var columns = []table.Column{
+	{Title: "ID", Width: 6},
+	{Title: "Name", Width: 24},
+	{Title: "Type", Width: 8},
+	{Title: "Role", Width: 16},
+}
+
+func ListMembers(members []*models.ProjectMemberEntity) {
+	var rows []table.Row
+	for _, member := range members {
+		memberType := "user"
+		if member.EntityType == "g" {
+			memberType = "group"
+		}
+		rows = append(rows, table.Row{
+			fmt.Sprintf("%d", member.ID),
+			member.EntityName,
+			memberType,
+			member.RoleName,
+		})
+	}
+
+	m := tablelist.NewModel(columns, rows, len(rows))
+	if _, err := tea.NewProgram(m).Run(); err != nil {
+		fmt.Println("Error running program:", err)
+		os.Exit(1)
+	}
+}
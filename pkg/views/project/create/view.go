@@ -34,6 +34,15 @@ func getRegistryList() (*registry.ListRegistriesOK, error) {
 	return response, nil
 }
 
+// PublicField asks whether the project is public.
+func PublicField(value *bool) *huh.Confirm {
+	return huh.NewConfirm().
+		Title("Public").
+		Value(value).
+		Affirmative("yes").
+		Negative("no")
+}
+
 func CreateProjectView(createView *CreateView) {
 	theme := huh.ThemeCharm()
 	// I want it to be a map of registry ID to registry name
@@ -64,11 +73,7 @@ func CreateProjectView(createView *CreateView) {
 					}
 					return nil
 				}),
-			huh.NewConfirm().
-				Title("Public").
-				Value(&createView.Public).
-				Affirmative("yes").
-				Negative("no"),
+			PublicField(&createView.Public),
 			huh.NewInput().
 				Title("Storage Limit").
 				Value(&createView.StorageLimit).
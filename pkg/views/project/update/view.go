@@ -0,0 +1,85 @@
+package update
+
+import (
+	"github.com/charmbracelet/huh"
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	"github.com/goharbor/harbor-cli/pkg/views/project/create"
+	log "github.com/sirupsen/logrus"
+)
+
+type UpdateView struct {
+	Public               bool
+	AutoScan             bool
+	PreventVul           bool
+	Severity             string
+	ContentTrust         bool
+	ContentTrustCosign   bool
+	ReuseSysCVEAllowlist bool
+	StorageLimit         string
+}
+
+// Severities are the thresholds accepted to prevent vulnerable images from running.
+var Severities = []string{"none", "low", "medium", "high", "critical"}
+
+func UpdateProjectView(updateView *UpdateView) {
+	theme := huh.ThemeCharm()
+
+	var severityOptions []huh.Option[string]
+	for _, severity := range Severities {
+		severityOptions = append(severityOptions, huh.NewOption(severity, severity))
+	}
+
+	err := huh.NewForm(
+		huh.NewGroup(
+			create.PublicField(&updateView.Public),
+			huh.NewConfirm().
+				Title("Automatically scan images on push").
+				Value(&updateView.AutoScan).
+				Affirmative("yes").
+				Negative("no"),
+			huh.NewConfirm().
+				Title("Prevent vulnerable images from running").
+				Value(&updateView.PreventVul).
+				Affirmative("yes").
+				Negative("no"),
+		),
+		huh.NewGroup(
+			huh.NewSelect[string]().
+				Title("Prevent images with vulnerability severity of").
+				Description("Images with vulnerabilities of this severity or higher cannot be pulled").
+				Value(&updateView.Severity).
+				Options(severityOptions...),
+		).WithHideFunc(func() bool {
+			return !updateView.PreventVul
+		}),
+		huh.NewGroup(
+			huh.NewConfirm().
+				Title("Enforce content trust (Notary)").
+				Value(&updateView.ContentTrust).
+				Affirmative("yes").
+				Negative("no"),
+			huh.NewConfirm().
+				Title("Enforce cosign signatures").
+				Value(&updateView.ContentTrustCosign).
+				Affirmative("yes").
+				Negative("no"),
+			huh.NewConfirm().
+				Title("Reuse the system CVE allowlist").
+				Value(&updateView.ReuseSysCVEAllowlist).
+				Affirmative("yes").
+				Negative("no"),
+			huh.NewInput().
+				Title("Storage Limit").
+				Description("Size such as 10GiB or 500MiB, -1 for unlimited").
+				Value(&updateView.StorageLimit).
+				Validate(func(str string) error {
+					_, err := utils.ParseStorageSize(str)
+					return err
+				}),
+		),
+	).WithTheme(theme).Run()
+
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,101 @@
+package usage
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	"github.com/goharbor/harbor-cli/pkg/views/base/tablelist"
+	quotaList "github.com/goharbor/harbor-cli/pkg/views/quota/list"
+)
+
+// ProjectUsage is the storage consumed by a project. Size adds up the
+// artifacts, so layers shared between them are counted more than once;
+// QuotaUsed is what Harbor accounts against the quota. The quota fields are
+// nil when the quota of the project could not be read.
+type ProjectUsage struct {
+	Name         string             `json:"name"`
+	Repositories int                `json:"repositories"`
+	Artifacts    int                `json:"artifacts"`
+	Size         int64              `json:"size"`
+	QuotaUsed    *int64             `json:"quota_used,omitempty"`
+	QuotaLimit   *int64             `json:"quota_limit,omitempty"`
+	Details      []*RepositoryUsage `json:"repository_usage,omitempty"`
+}
+
+// RepositoryUsage is the storage consumed by the artifacts of a repository.
+type RepositoryUsage struct {
+	Name      string `json:"name"`
+	Artifacts int    `json:"artifacts"`
+	Size      int64  `json:"size"`
+}
+
+var projectColumns = []table.Column{
+	{Title: "Project", Width: 20},
+	{Title: "Repos", Width: 6},
+	{Title: "Artifacts", Width: 9},
+	{Title: "Artifact Size", Width: 14},
+	{Title: "Quota Used", Width: 14},
+	{Title: "Quota Limit", Width: 14},
+	{Title: "Usage", Width: 8},
+}
+
+var repositoryColumns = []table.Column{
+	{Title: "Repository", Width: 40},
+	{Title: "Artifacts", Width: 9},
+	{Title: "Artifact Size", Width: 14},
+	{Title: "Share", Width: 8},
+}
+
+func ListProjectUsage(projects []*ProjectUsage) {
+	var rows []table.Row
+	for _, p := range projects {
+		quotaUsed, quotaLimit, quotaUsage := "unknown", "unknown", "-"
+		if p.QuotaUsed != nil && p.QuotaLimit != nil {
+			quotaUsed = utils.FormatSize(*p.QuotaUsed)
+			quotaLimit = quotaList.FormatLimit(*p.QuotaLimit)
+			quotaUsage = quotaList.FormatUsage(*p.QuotaUsed, *p.QuotaLimit)
+		}
+		rows = append(rows, table.Row{
+			p.Name,
+			fmt.Sprintf("%d", p.Repositories),
+			fmt.Sprintf("%d", p.Artifacts),
+			utils.FormatSize(p.Size),
+			quotaUsed,
+			quotaLimit,
+			quotaUsage,
+		})
+	}
+	run(projectColumns, rows)
+}
+
+// ListRepositoryUsage lists the repositories of the projects with their
+// share of the artifact size of their project.
+func ListRepositoryUsage(projects []*ProjectUsage) {
+	var rows []table.Row
+	for _, p := range projects {
+		for _, r := range p.Details {
+			share := "-"
+			if p.Size > 0 {
+				share = fmt.Sprintf("%.1f%%", float64(r.Size)*100/float64(p.Size))
+			}
+			rows = append(rows, table.Row{
+				r.Name,
+				fmt.Sprintf("%d", r.Artifacts),
+				utils.FormatSize(r.Size),
+				share,
+			})
+		}
+	}
+	run(repositoryColumns, rows)
+}
+
+func run(columns []table.Column, rows []table.Row) {
+	m := tablelist.NewModel(columns, rows, len(rows))
+	if _, err := tea.NewProgram(m).Run(); err != nil {
+		fmt.Println("Error running program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,68 @@
+package list
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	"github.com/goharbor/harbor-cli/pkg/views/base/tablelist"
+)
+
+var columns = []table.Column{
+	{Title: "ID", Width: 6},
+	{Title: "Project", Width: 20},
+	{Title: "Owner", Width: 12},
+	{Title: "Used", Width: 14},
+	{Title: "Limit", Width: 14},
+	{Title: "Usage", Width: 8},
+	{Title: "Update Time", Width: 16},
+}
+
+func ListQuotas(quotas []*models.Quota) {
+	var rows []table.Row
+	for _, quota := range quotas {
+		used, limit := quota.Used["storage"], quota.Hard["storage"]
+		updatedTime, _ := utils.FormatCreatedTime(quota.UpdateTime.String())
+		rows = append(rows, table.Row{
+			fmt.Sprintf("%d", quota.ID),
+			RefField(quota, "name"),
+			RefField(quota, "owner_name"),
+			utils.FormatSize(used),
+			FormatLimit(limit),
+			FormatUsage(used, limit),
+			updatedTime,
+		})
+	}
+
+	m := tablelist.NewModel(columns, rows, len(rows))
+	if _, err := tea.NewProgram(m).Run(); err != nil {
+		fmt.Println("Error running program:", err)
+		os.Exit(1)
+	}
+}
+
+// RefField returns a field of the project a quota belongs to.
+func RefField(quota *models.Quota, field string) string {
+	ref, ok := quota.Ref.(map[string]interface{})
+	if !ok || ref[field] == nil {
+		return ""
+	}
+	return fmt.Sprintf("%v", ref[field])
+}
+
+func FormatLimit(limit int64) string {
+	if limit < 0 {
+		return "Unlimited"
+	}
+	return utils.FormatSize(limit)
+}
+
+func FormatUsage(used, limit int64) string {
+	if limit <= 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.1f%%", float64(used)*100/float64(limit))
+}
@@ -0,0 +1,91 @@
+package providers
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/views/base/tablelist"
+)
+
+// Provider is a registry provider type with its credential and endpoint
+// patterns, which are unset for providers accepting any credential and URL.
+type Provider struct {
+	Type                        string `json:"type" yaml:"type"`
+	models.RegistryProviderInfo `yaml:",inline"`
+}
+
+var columns = []table.Column{
+	{Title: "Provider", Width: 22},
+	{Title: "Access Key", Width: 20},
+	{Title: "Access Secret", Width: 16},
+	{Title: "Endpoints", Width: 60},
+}
+
+func ListProviders(providers []Provider) {
+	var rows []table.Row
+	for _, provider := range providers {
+		rows = append(rows, table.Row{
+			provider.Type,
+			AccessKey(provider.CredentialPattern),
+			AccessSecret(provider.CredentialPattern),
+			Endpoints(provider.EndpointPattern),
+		})
+	}
+
+	m := tablelist.NewModel(columns, rows, len(rows))
+
+	if _, err := tea.NewProgram(m).Run(); err != nil {
+		fmt.Println("Error running program:", err)
+		os.Exit(1)
+	}
+}
+
+// AccessKey describes the access key a provider expects, such as the fixed
+// "_json_key" of Google GCR.
+func AccessKey(pattern *models.RegistryProviderCredentialPattern) string {
+	if pattern == nil || pattern.AccessKeyType == "" {
+		return "any"
+	}
+	return describePattern(pattern.AccessKeyType, pattern.AccessKeyData)
+}
+
+func AccessSecret(pattern *models.RegistryProviderCredentialPattern) string {
+	if pattern == nil || pattern.AccessSecretType == "" {
+		return "any"
+	}
+	return describePattern(pattern.AccessSecretType, pattern.AccessSecretData)
+}
+
+func describePattern(patternType, data string) string {
+	switch strings.ToUpper(patternType) {
+	case "FIX":
+		return fmt.Sprintf("must be %q", data)
+	case "FILE":
+		return "file content"
+	default:
+		if data != "" {
+			return fmt.Sprintf("%s: %s", strings.ToLower(patternType), data)
+		}
+		return strings.ToLower(patternType)
+	}
+}
+
+// Endpoints lists the endpoint URLs a provider accepts, or "any" when the URL
+// is free.
+func Endpoints(pattern *models.RegistryProviderEndpointPattern) string {
+	if pattern == nil || len(pattern.Endpoints) == 0 {
+		return "any"
+	}
+	var urls []string
+	for _, endpoint := range pattern.Endpoints {
+		urls = append(urls, endpoint.Value)
+	}
+	if strings.EqualFold(pattern.EndpointType, "FIX") {
+		return strings.Join(urls, ", ")
+	}
+	return "one of " + strings.Join(urls, ", ")
+}
@@ -12,15 +12,9 @@ func UpdateRegistryView(updateView *models.Registry) {
 	theme := huh.ThemeCharm()
 	err := huh.NewForm(
 		huh.NewGroup(
-			huh.NewInput().
+			huh.NewNote().
 				Title("Provider").
-				Value(&updateView.Type).
-				Validate(func(str string) error {
-					if str == "" {
-						return errors.New("provider cannot be empty")
-					}
-					return nil
-				}),
+				Description(updateView.Type),
 			huh.NewInput().
 				Title("Name").
 				Value(&updateView.Name).
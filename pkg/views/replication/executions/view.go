@@ -0,0 +1,112 @@
+package executions
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/go-openapi/strfmt"
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	"github.com/goharbor/harbor-cli/pkg/views/base/tablelist"
+)
+
+var executionColumns = []table.Column{
+	{Title: "ID", Width: 8},
+	{Title: "Status", Width: 10},
+	{Title: "Trigger", Width: 10},
+	{Title: "Progress", Width: 24},
+	{Title: "Start Time", Width: 16},
+	{Title: "Duration", Width: 10},
+}
+
+// TaskColumns are the columns of the task rows returned by TaskRows.
+var TaskColumns = []table.Column{
+	{Title: "ID", Width: 8},
+	{Title: "Status", Width: 10},
+	{Title: "Operation", Width: 9},
+	{Title: "Type", Width: 8},
+	{Title: "Source", Width: 36},
+	{Title: "Destination", Width: 36},
+}
+
+func ListExecutions(executions []*models.ReplicationExecution) {
+	var rows []table.Row
+	for _, execution := range executions {
+		rows = append(rows, table.Row{
+			fmt.Sprintf("%d", execution.ID),
+			execution.Status,
+			execution.Trigger,
+			Progress(execution),
+			FormatTime(execution.StartTime),
+			Duration(execution.StartTime, execution.EndTime),
+		})
+	}
+	run(executionColumns, rows)
+}
+
+func ListTasks(tasks []*models.ReplicationTask) {
+	run(TaskColumns, TaskRows(tasks))
+}
+
+// TaskRows returns the table rows of the tasks.
+func TaskRows(tasks []*models.ReplicationTask) []table.Row {
+	var rows []table.Row
+	for _, task := range tasks {
+		rows = append(rows, table.Row{
+			fmt.Sprintf("%d", task.ID),
+			task.Status,
+			task.Operation,
+			task.ResourceType,
+			task.SrcResource,
+			task.DstResource,
+		})
+	}
+	return rows
+}
+
+// Progress summarises the tasks of an execution such as "8/10 done, 1 failed".
+func Progress(execution *models.ReplicationExecution) string {
+	done := execution.Succeed + execution.Failed + execution.Stopped
+	progress := fmt.Sprintf("%d/%d done", done, execution.Total)
+	if execution.Failed > 0 {
+		progress += fmt.Sprintf(", %d failed", execution.Failed)
+	}
+	if execution.Stopped > 0 {
+		progress += fmt.Sprintf(", %d stopped", execution.Stopped)
+	}
+	return progress
+}
+
+func FormatTime(value strfmt.DateTime) string {
+	if time.Time(value).IsZero() {
+		return "-"
+	}
+	formatted, err := utils.FormatCreatedTime(value.String())
+	if err != nil {
+		return value.String()
+	}
+	return formatted
+}
+
+// Duration returns how long an execution ran, or has been running so far.
+func Duration(start, end strfmt.DateTime) string {
+	if time.Time(start).IsZero() {
+		return "-"
+	}
+	finish := time.Time(end)
+	if finish.IsZero() {
+		finish = time.Now()
+	}
+	return finish.Sub(time.Time(start)).Round(time.Second).String()
+}
+
+func run(columns []table.Column, rows []table.Row) {
+	m := tablelist.NewModel(columns, rows, len(rows))
+	if _, err := tea.NewProgram(m).Run(); err != nil {
+		fmt.Println("Error running program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,109 @@
+package list
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/views/base/tablelist"
+)
+
+var columns = []table.Column{
+	{Title: "ID", Width: 6},
+	{Title: "Name", Width: 20},
+	{Title: "Enabled", Width: 8},
+	{Title: "Mode", Width: 5},
+	{Title: "Registry", Width: 20},
+	{Title: "Namespace", Width: 16},
+	{Title: "Trigger", Width: 24},
+}
+
+func ListPolicies(policies []*models.ReplicationPolicy) {
+	var rows []table.Row
+	for _, policy := range policies {
+		enabled := "No"
+		if policy.Enabled {
+			enabled = "Yes"
+		}
+		rows = append(rows, table.Row{
+			fmt.Sprintf("%d", policy.ID),
+			policy.Name,
+			enabled,
+			Mode(policy),
+			RemoteRegistry(policy),
+			policy.DestNamespace,
+			Trigger(policy),
+		})
+	}
+
+	m := tablelist.NewModel(columns, rows, len(rows))
+	if _, err := tea.NewProgram(m).Run(); err != nil {
+		fmt.Println("Error running program:", err)
+		os.Exit(1)
+	}
+}
+
+// Mode returns "pull" for policies replicating from a remote registry and
+// "push" for those replicating to one.
+func Mode(policy *models.ReplicationPolicy) string {
+	if policy.SrcRegistry != nil && policy.SrcRegistry.ID != 0 {
+		return "pull"
+	}
+	return "push"
+}
+
+// RemoteRegistry returns the name of the registry the policy pulls from or
+// pushes to.
+func RemoteRegistry(policy *models.ReplicationPolicy) string {
+	registry := policy.DestRegistry
+	if Mode(policy) == "pull" {
+		registry = policy.SrcRegistry
+	}
+	if registry == nil {
+		return ""
+	}
+	return registry.Name
+}
+
+// Trigger describes when the policy runs.
+func Trigger(policy *models.ReplicationPolicy) string {
+	if policy.Trigger == nil {
+		return "manual"
+	}
+	switch policy.Trigger.Type {
+	case "scheduled":
+		if policy.Trigger.TriggerSettings != nil {
+			return "scheduled " + policy.Trigger.TriggerSettings.Cron
+		}
+		return "scheduled"
+	case "event_based":
+		return "event based"
+	case "":
+		return "manual"
+	default:
+		return policy.Trigger.Type
+	}
+}
+
+// Filter describes a filter of the policy.
+func Filter(filter *models.ReplicationFilter) string {
+	var value string
+	switch v := filter.Value.(type) {
+	case []interface{}:
+		var values []string
+		for _, item := range v {
+			values = append(values, fmt.Sprint(item))
+		}
+		value = strings.Join(values, ", ")
+	default:
+		value = fmt.Sprint(v)
+	}
+
+	if filter.Decoration == "excludes" {
+		return filter.Type + " excluding " + value
+	}
+	return filter.Type + " matching " + value
+}
@@ -0,0 +1,80 @@
+package view
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/views/base/tablelist"
+	"github.com/goharbor/harbor-cli/pkg/views/replication/list"
+)
+
+var columns = []table.Column{
+	{Title: "Setting", Width: 22},
+	{Title: "Value", Width: 50},
+}
+
+func ViewPolicy(policy *models.ReplicationPolicy) {
+	status := "enabled"
+	if !policy.Enabled {
+		status = "disabled"
+	}
+	direction := "to"
+	if list.Mode(policy) == "pull" {
+		direction = "from"
+	}
+	fmt.Printf("%s (ID %d, %s %s %s, %s)\n", policy.Name, policy.ID, list.Mode(policy), direction, list.RemoteRegistry(policy), status)
+	if policy.Description != "" {
+		fmt.Println(policy.Description)
+	}
+
+	rows := []table.Row{
+		{"Trigger", list.Trigger(policy)},
+		{"Destination namespace", policy.DestNamespace},
+		{"Flattening", flattening(policy.DestNamespaceReplaceCount)},
+		{"Override", yesNo(policy.Override)},
+		{"Replicate deletion", yesNo(policy.ReplicateDeletion)},
+		{"Speed limit", speed(policy.Speed)},
+	}
+	if policy.CopyByChunk != nil {
+		rows = append(rows, table.Row{"Copy by chunk", yesNo(*policy.CopyByChunk)})
+	}
+	for _, filter := range policy.Filters {
+		rows = append(rows, table.Row{"Filter", list.Filter(filter)})
+	}
+
+	m := tablelist.NewModel(columns, rows, len(rows))
+	if _, err := tea.NewProgram(m).Run(); err != nil {
+		fmt.Println("Error running program:", err)
+		os.Exit(1)
+	}
+}
+
+func yesNo(value bool) string {
+	if value {
+		return "Yes"
+	}
+	return "No"
+}
+
+func speed(limit *int32) string {
+	if limit == nil || *limit <= 0 {
+		return "unlimited"
+	}
+	return fmt.Sprintf("%d KB/s", *limit)
+}
+
+func flattening(count *int8) string {
+	switch {
+	case count == nil || *count < 0:
+		return "flatten all levels"
+	case *count == 0:
+		return "no flattening"
+	case *count == 1:
+		return "flatten 1 level"
+	default:
+		return fmt.Sprintf("flatten %d levels", *count)
+	}
+}
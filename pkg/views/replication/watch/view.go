@@ -0,0 +1,159 @@
+package watch
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/views"
+	"github.com/goharbor/harbor-cli/pkg/views/replication/executions"
+)
+
+// maxTasks is the number of unfinished or failed tasks shown while watching.
+const maxTasks = 10
+
+// Snapshot is the state of an execution at one poll.
+type Snapshot struct {
+	Execution *models.ReplicationExecution
+	Tasks     []*models.ReplicationTask
+}
+
+// FetchFunc returns the current state of the watched execution.
+type FetchFunc func() (Snapshot, error)
+
+type snapshotMsg struct {
+	snapshot Snapshot
+	err      error
+}
+
+type model struct {
+	spinner  spinner.Model
+	fetch    FetchFunc
+	interval time.Duration
+	snapshot Snapshot
+	err      error
+}
+
+// Watch renders the progress of an execution, polling fetch every interval
+// until the execution finishes or the user quits, and returns the last state.
+func Watch(fetch FetchFunc, interval time.Duration) (Snapshot, error) {
+	m := model{
+		spinner:  spinner.New(spinner.WithSpinner(spinner.Dot)),
+		fetch:    fetch,
+		interval: interval,
+	}
+
+	final, err := tea.NewProgram(m).Run()
+	if err != nil {
+		return Snapshot{}, err
+	}
+	if result, ok := final.(model); ok {
+		return result.snapshot, result.err
+	}
+	return Snapshot{}, fmt.Errorf("unexpected model %T", final)
+}
+
+func (m model) poll() tea.Msg {
+	snapshot, err := m.fetch()
+	return snapshotMsg{snapshot: snapshot, err: err}
+}
+
+func (m model) Init() tea.Cmd {
+	return tea.Batch(m.spinner.Tick, m.poll)
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "q", "esc", "ctrl+c":
+			return m, tea.Quit
+		}
+	case snapshotMsg:
+		if msg.err != nil {
+			m.err = msg.err
+			return m, tea.Quit
+		}
+		m.snapshot = msg.snapshot
+		if !Running(m.snapshot.Execution) {
+			return m, tea.Quit
+		}
+		return m, tea.Tick(m.interval, func(time.Time) tea.Msg { return m.poll() })
+	case spinner.TickMsg:
+		var cmd tea.Cmd
+		m.spinner, cmd = m.spinner.Update(msg)
+		return m, cmd
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	execution := m.snapshot.Execution
+	if execution == nil {
+		return m.spinner.View() + " Waiting for the execution...\n"
+	}
+
+	var b strings.Builder
+	status := execution.Status
+	switch {
+	case Running(execution):
+		b.WriteString(m.spinner.View() + " ")
+	case status == "Succeed":
+		status = views.GreenStyle.Render(status)
+	default:
+		status = views.RedStyle.Render(status)
+	}
+	fmt.Fprintf(&b, "Execution %d %s, %s\n", execution.ID, status, executions.Duration(execution.StartTime, execution.EndTime))
+	fmt.Fprintf(&b, "%s %s\n", bar(execution, 40), executions.Progress(execution))
+	if execution.StatusText != "" && !Running(execution) {
+		b.WriteString(execution.StatusText + "\n")
+	}
+
+	var tasks []*models.ReplicationTask
+	for _, task := range m.snapshot.Tasks {
+		if task.Status != "Succeed" {
+			tasks = append(tasks, task)
+		}
+	}
+	if len(tasks) > 0 {
+		shown := tasks
+		if len(shown) > maxTasks {
+			shown = shown[:maxTasks]
+		}
+		t := table.New(
+			table.WithColumns(executions.TaskColumns),
+			table.WithRows(executions.TaskRows(shown)),
+		)
+		t.SetHeight(len(shown) + lipgloss.Height(table.DefaultStyles().Header.Render()))
+		b.WriteString(views.BaseStyle.Render(t.View()) + "\n")
+		if len(tasks) > len(shown) {
+			fmt.Fprintf(&b, "and %d more\n", len(tasks)-len(shown))
+		}
+	}
+
+	if Running(execution) {
+		b.WriteString(views.HelpStyle.Render("press q to stop watching, the replication keeps running") + "\n")
+	}
+	return b.String()
+}
+
+// Running reports whether the execution has not finished yet.
+func Running(execution *models.ReplicationExecution) bool {
+	return execution != nil && execution.Status == "InProgress"
+}
+
+func bar(execution *models.ReplicationExecution, width int) string {
+	filled := 0
+	if execution.Total > 0 {
+		done := execution.Succeed + execution.Failed + execution.Stopped
+		filled = int(done * int64(width) / execution.Total)
+	} else if !Running(execution) {
+		filled = width
+	}
+	return "[" + strings.Repeat("█", filled) + strings.Repeat("░", width-filled) + "]"
+}
@@ -0,0 +1,83 @@
+package executions
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	"github.com/goharbor/harbor-cli/pkg/views/base/tablelist"
+)
+
+var executionColumns = []table.Column{
+	{Title: "ID", Width: 6},
+	{Title: "Status", Width: 10},
+	{Title: "Trigger", Width: 10},
+	{Title: "Dry Run", Width: 8},
+	{Title: "Start Time", Width: 16},
+	{Title: "End Time", Width: 16},
+}
+
+var taskColumns = []table.Column{
+	{Title: "ID", Width: 6},
+	{Title: "Repository", Width: 36},
+	{Title: "Status", Width: 10},
+	{Title: "Retained", Width: 10},
+	{Title: "Deleted", Width: 10},
+	{Title: "End Time", Width: 16},
+}
+
+func ListExecutions(executions []*models.RetentionExecution) {
+	var rows []table.Row
+	for _, execution := range executions {
+		dryRun := "No"
+		if execution.DryRun {
+			dryRun = "Yes"
+		}
+		rows = append(rows, table.Row{
+			fmt.Sprintf("%d", execution.ID),
+			execution.Status,
+			execution.Trigger,
+			dryRun,
+			formatTime(execution.StartTime),
+			formatTime(execution.EndTime),
+		})
+	}
+	run(executionColumns, rows)
+}
+
+func ListTasks(tasks []*models.RetentionExecutionTask) {
+	var rows []table.Row
+	for _, task := range tasks {
+		rows = append(rows, table.Row{
+			fmt.Sprintf("%d", task.ID),
+			task.Repository,
+			task.Status,
+			fmt.Sprintf("%d/%d", task.Retained, task.Total),
+			fmt.Sprintf("%d", task.Total-task.Retained),
+			formatTime(task.EndTime),
+		})
+	}
+	run(taskColumns, rows)
+}
+
+func formatTime(value string) string {
+	if value == "" {
+		return "-"
+	}
+	formatted, err := utils.FormatCreatedTime(value)
+	if err != nil {
+		return value
+	}
+	return formatted
+}
+
+func run(columns []table.Column, rows []table.Row) {
+	m := tablelist.NewModel(columns, rows, len(rows))
+	if _, err := tea.NewProgram(m).Run(); err != nil {
+		fmt.Println("Error running program:", err)
+		os.Exit(1)
+	}
+}
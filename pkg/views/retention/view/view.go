@@ -0,0 +1,100 @@
+package view
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/views/base/tablelist"
+)
+
+var columns = []table.Column{
+	{Title: "#", Width: 3},
+	{Title: "Status", Width: 8},
+	{Title: "Repositories", Width: 24},
+	{Title: "Tags", Width: 30},
+	{Title: "Rule", Width: 40},
+}
+
+func ViewRetention(policy *models.RetentionPolicy) {
+	fmt.Printf("Retention policy %d, schedule: %s\n", policy.ID, Schedule(policy))
+
+	var rows []table.Row
+	for i, rule := range policy.Rules {
+		status := "enabled"
+		if rule.Disabled {
+			status = "disabled"
+		}
+
+		var repositories []string
+		for _, selector := range rule.ScopeSelectors["repository"] {
+			repositories = append(repositories, describeSelector(selector))
+		}
+		var tags []string
+		for _, selector := range rule.TagSelectors {
+			tags = append(tags, describeSelector(*selector))
+		}
+
+		rows = append(rows, table.Row{
+			fmt.Sprintf("%d", i+1),
+			status,
+			strings.Join(repositories, ", "),
+			strings.Join(tags, ", "),
+			DescribeRule(rule),
+		})
+	}
+
+	m := tablelist.NewModel(columns, rows, len(rows))
+	if _, err := tea.NewProgram(m).Run(); err != nil {
+		fmt.Println("Error running program:", err)
+		os.Exit(1)
+	}
+}
+
+// Schedule returns the cron of the policy, or "None" when it only runs manually.
+func Schedule(policy *models.RetentionPolicy) string {
+	if policy.Trigger != nil {
+		if settings, ok := policy.Trigger.Settings.(map[string]interface{}); ok {
+			if cron, ok := settings["cron"].(string); ok && cron != "" {
+				return cron
+			}
+		}
+	}
+	return "None"
+}
+
+// DescribeRule returns what a rule retains in words.
+func DescribeRule(rule *models.RetentionRule) string {
+	value := rule.Params[rule.Template]
+	switch rule.Template {
+	case "latestPushedK":
+		return fmt.Sprintf("retain the %v most recently pushed", value)
+	case "latestPulledN":
+		return fmt.Sprintf("retain the %v most recently pulled", value)
+	case "nDaysSinceLastPush":
+		return fmt.Sprintf("retain pushed within the last %v days", value)
+	case "nDaysSinceLastPull":
+		return fmt.Sprintf("retain pulled within the last %v days", value)
+	case "always":
+		return "retain always"
+	default:
+		return rule.Template
+	}
+}
+
+func describeSelector(selector models.RetentionSelector) string {
+	var text string
+	switch selector.Decoration {
+	case "excludes", "repoExcludes":
+		text = "excluding " + selector.Pattern
+	default:
+		text = "matching " + selector.Pattern
+	}
+	if strings.Contains(selector.Extras, `"untagged":true`) {
+		text += " (with untagged)"
+	}
+	return text
+}
@@ -0,0 +1,65 @@
+package list
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	"github.com/goharbor/harbor-cli/pkg/views/base/tablelist"
+)
+
+var columns = []table.Column{
+	{Title: "ID", Width: 6},
+	{Title: "Name", Width: 30},
+	{Title: "Level", Width: 8},
+	{Title: "Status", Width: 9},
+	{Title: "Permissions", Width: 12},
+	{Title: "Expires", Width: 12},
+	{Title: "Creation Time", Width: 15},
+}
+
+func ListRobots(robots []*models.Robot) {
+	var rows []table.Row
+	for _, robot := range robots {
+		status := "Enabled"
+		if robot.Disable {
+			status = "Disabled"
+		}
+		var permissions int
+		for _, permission := range robot.Permissions {
+			permissions += len(permission.Access)
+		}
+		createdTime, _ := utils.FormatCreatedTime(robot.CreationTime.String())
+		rows = append(rows, table.Row{
+			fmt.Sprintf("%d", robot.ID),
+			robot.Name,
+			robot.Level,
+			status,
+			fmt.Sprintf("%d", permissions),
+			FormatExpiry(robot.ExpiresAt),
+			createdTime,
+		})
+	}
+
+	m := tablelist.NewModel(columns, rows, len(rows))
+	if _, err := tea.NewProgram(m).Run(); err != nil {
+		fmt.Println("Error running program:", err)
+		os.Exit(1)
+	}
+}
+
+// FormatExpiry renders the expiry of a robot account given in Unix time.
+func FormatExpiry(expiresAt int64) string {
+	if expiresAt <= 0 {
+		return "Never"
+	}
+	expiry := time.Unix(expiresAt, 0)
+	if expiry.Before(time.Now()) {
+		return "Expired"
+	}
+	return expiry.Format("2006-01-02")
+}
@@ -0,0 +1,48 @@
+package view
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/views/base/tablelist"
+	"github.com/goharbor/harbor-cli/pkg/views/robot/list"
+)
+
+var columns = []table.Column{
+	{Title: "Kind", Width: 8},
+	{Title: "Namespace", Width: 20},
+	{Title: "Resource", Width: 24},
+	{Title: "Action", Width: 12},
+}
+
+func ViewRobot(robot *models.Robot) {
+	status := "enabled"
+	if robot.Disable {
+		status = "disabled"
+	}
+	fmt.Printf("%s (ID %d, %s level, %s, expires %s)\n", robot.Name, robot.ID, robot.Level, status, list.FormatExpiry(robot.ExpiresAt))
+	if robot.Description != "" {
+		fmt.Println(robot.Description)
+	}
+
+	var rows []table.Row
+	for _, permission := range robot.Permissions {
+		for _, access := range permission.Access {
+			rows = append(rows, table.Row{
+				permission.Kind,
+				permission.Namespace,
+				access.Resource,
+				access.Action,
+			})
+		}
+	}
+
+	m := tablelist.NewModel(columns, rows, len(rows))
+	if _, err := tea.NewProgram(m).Run(); err != nil {
+		fmt.Println("Error running program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,84 @@
+package jobs
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/utils"
+	"github.com/goharbor/harbor-cli/pkg/views/base/tablelist"
+)
+
+var executionColumns = []table.Column{
+	{Title: "ID", Width: 8},
+	{Title: "Event", Width: 20},
+	{Title: "Status", Width: 10},
+	{Title: "Start Time", Width: 16},
+	{Title: "Message", Width: 40},
+}
+
+var taskColumns = []table.Column{
+	{Title: "ID", Width: 8},
+	{Title: "Status", Width: 10},
+	{Title: "Attempts", Width: 8},
+	{Title: "Start Time", Width: 16},
+	{Title: "End Time", Width: 16},
+	{Title: "Message", Width: 40},
+}
+
+func ListExecutions(executions []*models.Execution) {
+	var rows []table.Row
+	for _, execution := range executions {
+		rows = append(rows, table.Row{
+			fmt.Sprintf("%d", execution.ID),
+			eventType(execution),
+			execution.Status,
+			formatTime(execution.StartTime),
+			execution.StatusMessage,
+		})
+	}
+	run(executionColumns, rows)
+}
+
+func ListTasks(tasks []*models.Task) {
+	var rows []table.Row
+	for _, task := range tasks {
+		rows = append(rows, table.Row{
+			fmt.Sprintf("%d", task.ID),
+			task.Status,
+			fmt.Sprintf("%d", task.RunCount),
+			formatTime(task.StartTime),
+			formatTime(task.EndTime),
+			task.StatusMessage,
+		})
+	}
+	run(taskColumns, rows)
+}
+
+func eventType(execution *models.Execution) string {
+	if eventType, ok := execution.ExtraAttrs["event_type"].(string); ok {
+		return eventType
+	}
+	return "-"
+}
+
+func formatTime(value string) string {
+	if value == "" {
+		return "-"
+	}
+	formatted, err := utils.FormatCreatedTime(value)
+	if err != nil {
+		return value
+	}
+	return formatted
+}
+
+func run(columns []table.Column, rows []table.Row) {
+	m := tablelist.NewModel(columns, rows, len(rows))
+	if _, err := tea.NewProgram(m).Run(); err != nil {
+		fmt.Println("Error running program:", err)
+		os.Exit(1)
+	}
+}
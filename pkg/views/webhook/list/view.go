@@ -0,0 +1,50 @@
+package list
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/goharbor/go-client/pkg/sdk/v2.0/models"
+	"github.com/goharbor/harbor-cli/pkg/views/base/tablelist"
+)
+
+var columns = []table.Column{
+	{Title: "ID", Width: 6},
+	{Title: "Name", Width: 20},
+	{Title: "Enabled", Width: 8},
+	{Title: "Type", Width: 6},
+	{Title: "Address", Width: 36},
+	{Title: "Events", Width: 40},
+}
+
+func ListWebhooks(policies []*models.WebhookPolicy) {
+	var rows []table.Row
+	for _, policy := range policies {
+		enabled := "No"
+		if policy.Enabled {
+			enabled = "Yes"
+		}
+		var types, addresses []string
+		for _, target := range policy.Targets {
+			types = append(types, target.Type)
+			addresses = append(addresses, target.Address)
+		}
+		rows = append(rows, table.Row{
+			fmt.Sprintf("%d", policy.ID),
+			policy.Name,
+			enabled,
+			strings.Join(types, ", "),
+			strings.Join(addresses, ", "),
+			strings.Join(policy.EventTypes, ", "),
+		})
+	}
+
+	m := tablelist.NewModel(columns, rows, len(rows))
+	if _, err := tea.NewProgram(m).Run(); err != nil {
+		fmt.Println("Error running program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,31 @@
+package listen
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/goharbor/harbor-cli/pkg/webhook"
+)
+
+const rowFormat = "%-19s  %-20s  %-12s  %s\n"
+
+// PrintHeader prints the header of the live event table.
+func PrintHeader() {
+	fmt.Printf(rowFormat, "TIME", "EVENT", "OPERATOR", "DETAILS")
+}
+
+// PrintEvent prints an event as a row of the live event table.
+func PrintEvent(event webhook.Event) {
+	fmt.Printf(rowFormat, event.Received.Format("2006-01-02 15:04:05"), event.Payload.Type,
+		event.Payload.Operator, webhook.Summary(event.Payload))
+}
+
+// PrintJSON prints an event as one line of JSON.
+func PrintJSON(event webhook.Event) {
+	line, err := json.Marshal(event.Payload)
+	if err != nil {
+		fmt.Println("Error encoding event:", err)
+		return
+	}
+	fmt.Println(string(line))
+}
@@ -0,0 +1,181 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Event types Harbor sends webhooks for.
+const (
+	EventPushArtifact      = "PUSH_ARTIFACT"
+	EventPullArtifact      = "PULL_ARTIFACT"
+	EventDeleteArtifact    = "DELETE_ARTIFACT"
+	EventScanningCompleted = "SCANNING_COMPLETED"
+	EventScanningFailed    = "SCANNING_FAILED"
+	EventScanningStopped   = "SCANNING_STOPPED"
+	EventQuotaExceed       = "QUOTA_EXCEED"
+	EventQuotaWarning      = "QUOTA_WARNING"
+	EventReplication       = "REPLICATION"
+	EventTagRetention      = "TAG_RETENTION"
+)
+
+// cloudEvent is a CloudEvents event in structured mode.
+type cloudEvent struct {
+	Type     string          `json:"type"`
+	Time     string          `json:"time"`
+	Operator string          `json:"operator"`
+	Data     json.RawMessage `json:"data"`
+}
+
+// Decode parses a webhook request body in the Default or CloudEvents
+// payload format into a Payload.
+func Decode(header http.Header, body []byte) (Payload, error) {
+	var payload Payload
+
+	mediaType, _, _ := mime.ParseMediaType(header.Get("Content-Type"))
+	switch {
+	case header.Get("ce-type") != "":
+		// CloudEvents in binary mode carry the attributes as headers.
+		payload.Type = harborEventType(header.Get("ce-type"))
+		payload.Operator = header.Get("ce-operator")
+		payload.OccurAt = parseEventTime(header.Get("ce-time"))
+		payload.EventData = &EventData{}
+		if err := json.Unmarshal(body, payload.EventData); err != nil {
+			return payload, fmt.Errorf("invalid CloudEvents data: %v", err)
+		}
+	case mediaType == "application/cloudevents+json":
+		var event cloudEvent
+		if err := json.Unmarshal(body, &event); err != nil {
+			return payload, fmt.Errorf("invalid CloudEvents event: %v", err)
+		}
+		payload.Type = harborEventType(event.Type)
+		payload.Operator = event.Operator
+		payload.OccurAt = parseEventTime(event.Time)
+		payload.EventData = &EventData{}
+		if len(event.Data) > 0 {
+			if err := json.Unmarshal(event.Data, payload.EventData); err != nil {
+				return payload, fmt.Errorf("invalid CloudEvents data: %v", err)
+			}
+		}
+	default:
+		if err := json.Unmarshal(body, &payload); err != nil {
+			return payload, fmt.Errorf("invalid webhook payload: %v", err)
+		}
+	}
+
+	if payload.Type == "" {
+		return payload, fmt.Errorf("webhook payload has no event type")
+	}
+	return payload, nil
+}
+
+// harborEventType is the inverse of CloudEventType, mapping for example
+// harbor.artifact.pushed to PUSH_ARTIFACT and harbor.foo to FOO.
+func harborEventType(cloudEventType string) string {
+	for eventType, t := range cloudEventTypes {
+		if t == cloudEventType {
+			return eventType
+		}
+	}
+	if name, ok := strings.CutPrefix(cloudEventType, "harbor."); ok {
+		return strings.ToUpper(name)
+	}
+	return cloudEventType
+}
+
+func parseEventTime(value string) int64 {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return 0
+	}
+	return t.Unix()
+}
+
+// Summary describes what an event is about in one line.
+func Summary(payload Payload) string {
+	data := payload.EventData
+	if data == nil {
+		return ""
+	}
+
+	switch payload.Type {
+	case EventReplication:
+		if r := data.Replication; r != nil {
+			return fmt.Sprintf("%s %s -> %s, %d succeeded, %d failed", r.JobStatus,
+				replicationEndpoint(r.SrcResource), replicationEndpoint(r.DestResource),
+				len(r.SuccessfulArtifact), len(r.FailedArtifact))
+		}
+	case EventTagRetention:
+		if r := data.Retention; r != nil {
+			return fmt.Sprintf("%s %s, retained %d of %d, deleted %d", r.ProjectName, r.Status,
+				r.Retained, r.Total, len(r.DeletedArtifact))
+		}
+	case EventQuotaExceed, EventQuotaWarning:
+		var details []string
+		for _, value := range data.Custom {
+			details = append(details, value)
+		}
+		sort.Strings(details)
+		return strings.Join(append(resourceURLs(data), details...), " ")
+	case EventScanningCompleted, EventScanningFailed, EventScanningStopped:
+		var parts []string
+		for _, resource := range data.Resources {
+			parts = append(parts, resource.ResourceURL+scanSummary(resource.ScanOverview))
+		}
+		return strings.Join(parts, ", ")
+	}
+	return strings.Join(resourceURLs(data), ", ")
+}
+
+func resourceURLs(data *EventData) []string {
+	var urls []string
+	for _, resource := range data.Resources {
+		url := resource.ResourceURL
+		if url == "" && data.Repository != nil {
+			url = data.Repository.RepoFullName + "@" + resource.Digest
+		}
+		urls = append(urls, url)
+	}
+	return urls
+}
+
+func replicationEndpoint(resource *ReplicationResource) string {
+	if resource == nil {
+		return "?"
+	}
+	endpoint := resource.Endpoint
+	if resource.RegistryName != "" {
+		endpoint = resource.RegistryName
+	}
+	if resource.Namespace != "" {
+		endpoint += "/" + resource.Namespace
+	}
+	return endpoint
+}
+
+// scanSummary reads the scan status, severity and vulnerability count from
+// a scan overview keyed by report MIME type.
+func scanSummary(overview map[string]interface{}) string {
+	for _, value := range overview {
+		report, ok := value.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		summary := fmt.Sprintf(" scan %v", report["scan_status"])
+		if severity, ok := report["severity"].(string); ok && severity != "" {
+			summary += ", severity " + severity
+		}
+		if counts, ok := report["summary"].(map[string]interface{}); ok {
+			if total, ok := counts["total"].(float64); ok {
+				summary += fmt.Sprintf(", %d vulnerabilities", int(total))
+			}
+		}
+		return summary
+	}
+	return ""
+}
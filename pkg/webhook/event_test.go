@@ -0,0 +1,194 @@
+package webhook
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecode(t *testing.T) {
+	pushed := &EventData{
+		Resources: []*Resource{{
+			Digest:      "sha256:abc",
+			Tag:         "v1",
+			ResourceURL: "harbor.example.com/library/app:v1",
+		}},
+		Repository: &Repository{Name: "app", Namespace: "library", RepoFullName: "library/app", RepoType: "public"},
+	}
+
+	tests := []struct {
+		name    string
+		header  http.Header
+		body    string
+		want    Payload
+		wantErr string
+	}{
+		{
+			name:   "default format",
+			header: http.Header{"Content-Type": {"application/json"}},
+			body: `{"type":"PUSH_ARTIFACT","occur_at":1718000000,"operator":"admin","event_data":{
+				"resources":[{"digest":"sha256:abc","tag":"v1","resource_url":"harbor.example.com/library/app:v1"}],
+				"repository":{"name":"app","namespace":"library","repo_full_name":"library/app","repo_type":"public"}}}`,
+			want: Payload{Type: EventPushArtifact, OccurAt: 1718000000, Operator: "admin", EventData: pushed},
+		},
+		{
+			name: "CloudEvents binary mode",
+			header: http.Header{
+				"Content-Type": {"application/json"},
+				"Ce-Type":      {"harbor.artifact.pushed"},
+				"Ce-Time":      {"2024-06-10T06:13:20Z"},
+				"Ce-Operator":  {"admin"},
+			},
+			body: `{"resources":[{"digest":"sha256:abc","tag":"v1","resource_url":"harbor.example.com/library/app:v1"}],
+				"repository":{"name":"app","namespace":"library","repo_full_name":"library/app","repo_type":"public"}}`,
+			want: Payload{Type: EventPushArtifact, OccurAt: 1718000000, Operator: "admin", EventData: pushed},
+		},
+		{
+			name:   "CloudEvents structured mode",
+			header: http.Header{"Content-Type": {"application/cloudevents+json; charset=utf-8"}},
+			body: `{"specversion":"1.0","type":"harbor.artifact.pushed","time":"2024-06-10T06:13:20Z","operator":"admin","data":{
+				"resources":[{"digest":"sha256:abc","tag":"v1","resource_url":"harbor.example.com/library/app:v1"}],
+				"repository":{"name":"app","namespace":"library","repo_full_name":"library/app","repo_type":"public"}}}`,
+			want: Payload{Type: EventPushArtifact, OccurAt: 1718000000, Operator: "admin", EventData: pushed},
+		},
+		{
+			name:   "CloudEvents without data",
+			header: http.Header{"Content-Type": {"application/cloudevents+json"}},
+			body:   `{"type":"harbor.quota.warned","time":"not a time"}`,
+			want:   Payload{Type: EventQuotaWarning, EventData: &EventData{}},
+		},
+		{
+			name:   "unknown CloudEvents type",
+			header: http.Header{"Ce-Type": {"com.example.custom"}},
+			body:   `{}`,
+			want:   Payload{Type: "com.example.custom", EventData: &EventData{}},
+		},
+		{
+			name:    "invalid default payload",
+			header:  http.Header{"Content-Type": {"application/json"}},
+			body:    `{"type":`,
+			wantErr: "invalid webhook payload",
+		},
+		{
+			name:    "invalid binary data",
+			header:  http.Header{"Ce-Type": {"harbor.artifact.pushed"}},
+			body:    `[]`,
+			wantErr: "invalid CloudEvents data",
+		},
+		{
+			name:    "invalid structured event",
+			header:  http.Header{"Content-Type": {"application/cloudevents+json"}},
+			body:    `not json`,
+			wantErr: "invalid CloudEvents event",
+		},
+		{
+			name:    "invalid structured data",
+			header:  http.Header{"Content-Type": {"application/cloudevents+json"}},
+			body:    `{"type":"harbor.artifact.pushed","data":"text"}`,
+			wantErr: "invalid CloudEvents data",
+		},
+		{
+			name:    "missing type",
+			header:  http.Header{"Content-Type": {"application/json"}},
+			body:    `{"occur_at":1718000000}`,
+			wantErr: "webhook payload has no event type",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Decode(tt.header, []byte(tt.body))
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSummary(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload Payload
+		want    string
+	}{
+		{
+			name: "push",
+			payload: Payload{Type: EventPushArtifact, EventData: &EventData{
+				Resources: []*Resource{{ResourceURL: "h/library/app:v1"}, {ResourceURL: "h/library/app:v2"}},
+			}},
+			want: "h/library/app:v1, h/library/app:v2",
+		},
+		{
+			name: "delete without resource URL",
+			payload: Payload{Type: EventDeleteArtifact, EventData: &EventData{
+				Resources:  []*Resource{{Digest: "sha256:abc"}},
+				Repository: &Repository{RepoFullName: "library/app"},
+			}},
+			want: "library/app@sha256:abc",
+		},
+		{
+			name: "scan",
+			payload: Payload{Type: EventScanningCompleted, EventData: &EventData{
+				Resources: []*Resource{{
+					ResourceURL: "h/library/app:v1",
+					ScanOverview: map[string]interface{}{
+						"application/vnd.security.vulnerability.report; version=1.1": map[string]interface{}{
+							"scan_status": "Success",
+							"severity":    "High",
+							"summary":     map[string]interface{}{"total": float64(12)},
+						},
+					},
+				}},
+			}},
+			want: "h/library/app:v1 scan Success, severity High, 12 vulnerabilities",
+		},
+		{
+			name: "quota",
+			payload: Payload{Type: EventQuotaWarning, EventData: &EventData{
+				Resources: []*Resource{{ResourceURL: "h/library/app:v1"}},
+				Custom:    map[string]string{"Details": "quota usage reach 85%"},
+			}},
+			want: "h/library/app:v1 quota usage reach 85%",
+		},
+		{
+			name: "replication",
+			payload: Payload{Type: EventReplication, EventData: &EventData{
+				Replication: &Replication{
+					JobStatus:          "Success",
+					SrcResource:        &ReplicationResource{Endpoint: "https://harbor.example.com", Namespace: "library"},
+					DestResource:       &ReplicationResource{RegistryName: "dockerhub", Namespace: "acme"},
+					SuccessfulArtifact: []*ReplicatedArtifact{{NameTag: "app:v1"}},
+				},
+			}},
+			want: "Success https://harbor.example.com/library -> dockerhub/acme, 1 succeeded, 0 failed",
+		},
+		{
+			name:    "no data",
+			payload: Payload{Type: EventPushArtifact},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Summary(tt.payload))
+		})
+	}
+}
+
+func TestEventTypeRoundTrip(t *testing.T) {
+	eventTypes := []string{EventPushArtifact, EventDeleteArtifact, EventScanningCompleted, EventQuotaExceed, EventReplication, EventTagRetention, "FOO", "IMAGE_SIGNED"}
+	for _, eventType := range eventTypes {
+		t.Run(eventType, func(t *testing.T) {
+			assert.Equal(t, eventType, harborEventType(CloudEventType(eventType)))
+		})
+	}
+
+	assert.Equal(t, "harbor.foo", CloudEventType("FOO"))
+	assert.Equal(t, "FOO", harborEventType("harbor.foo"))
+	assert.Equal(t, "com.example.custom", harborEventType("com.example.custom"))
+}
@@ -0,0 +1,64 @@
+package webhook
+
+import (
+	"crypto/subtle"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+)
+
+// maxPayloadSize limits the size of webhook requests the listener reads.
+const maxPayloadSize = 10 << 20
+
+var errUnauthorized = errors.New("missing or wrong Authorization header")
+
+// Event is a webhook request received by a Listener.
+type Event struct {
+	Received time.Time
+	Payload  Payload
+}
+
+// Listener is an http.Handler that receives Harbor webhooks, checks their
+// Authorization header and passes the decoded events to OnEvent.
+type Listener struct {
+	// AuthHeader is the Authorization header requests must carry, if set.
+	AuthHeader string
+	OnEvent    func(Event)
+	// OnError is called with requests that are rejected.
+	OnError func(r *http.Request, err error)
+}
+
+func (l *Listener) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if l.AuthHeader != "" && subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), []byte(l.AuthHeader)) != 1 {
+		l.reject(w, r, http.StatusUnauthorized, errUnauthorized)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxPayloadSize))
+	if err != nil {
+		l.reject(w, r, http.StatusBadRequest, err)
+		return
+	}
+	payload, err := Decode(r.Header, body)
+	if err != nil {
+		l.reject(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	if l.OnEvent != nil {
+		l.OnEvent(Event{Received: time.Now(), Payload: payload})
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (l *Listener) reject(w http.ResponseWriter, r *http.Request, status int, err error) {
+	if l.OnError != nil {
+		l.OnError(r, err)
+	}
+	http.Error(w, err.Error(), status)
+}
@@ -0,0 +1,150 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListener(t *testing.T) {
+	payload, err := json.Marshal(SamplePayload("library", time.Unix(1718000000, 0)))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		authHeader    string
+		method        string
+		authorization string
+		body          []byte
+		wantStatus    int
+		wantEvent     bool
+		wantRejected  bool
+	}{
+		{
+			name:          "valid",
+			authHeader:    "Bearer secret",
+			method:        http.MethodPost,
+			authorization: "Bearer secret",
+			body:          payload,
+			wantStatus:    http.StatusOK,
+			wantEvent:     true,
+		},
+		{
+			name:       "no header required",
+			method:     http.MethodPost,
+			body:       payload,
+			wantStatus: http.StatusOK,
+			wantEvent:  true,
+		},
+		{
+			name:         "missing header",
+			authHeader:   "Bearer secret",
+			method:       http.MethodPost,
+			body:         payload,
+			wantStatus:   http.StatusUnauthorized,
+			wantRejected: true,
+		},
+		{
+			name:          "wrong header",
+			authHeader:    "Bearer secret",
+			method:        http.MethodPost,
+			authorization: "Bearer secreT",
+			body:          payload,
+			wantStatus:    http.StatusUnauthorized,
+			wantRejected:  true,
+		},
+		{
+			name:          "header prefix",
+			authHeader:    "Bearer secret",
+			method:        http.MethodPost,
+			authorization: "Bearer secret-and-more",
+			body:          payload,
+			wantStatus:    http.StatusUnauthorized,
+			wantRejected:  true,
+		},
+		{
+			name:          "GET",
+			authHeader:    "Bearer secret",
+			method:        http.MethodGet,
+			authorization: "Bearer secret",
+			wantStatus:    http.StatusMethodNotAllowed,
+		},
+		{
+			name:          "invalid payload",
+			authHeader:    "Bearer secret",
+			method:        http.MethodPost,
+			authorization: "Bearer secret",
+			body:          []byte(`{"type":`),
+			wantStatus:    http.StatusBadRequest,
+			wantRejected:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var events []Event
+			var rejected []error
+			listener := &Listener{
+				AuthHeader: tt.authHeader,
+				OnEvent:    func(event Event) { events = append(events, event) },
+				OnError:    func(r *http.Request, err error) { rejected = append(rejected, err) },
+			}
+
+			req := httptest.NewRequest(tt.method, "/", bytes.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			rec := httptest.NewRecorder()
+			listener.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantEvent {
+				require.Len(t, events, 1)
+				assert.Equal(t, EventPushArtifact, events[0].Payload.Type)
+				assert.Equal(t, "library/webhook-test", events[0].Payload.EventData.Repository.RepoFullName)
+				assert.False(t, events[0].Received.IsZero())
+			} else {
+				assert.Empty(t, events, "rejected requests must not reach OnEvent")
+			}
+			if tt.wantRejected {
+				require.Len(t, rejected, 1)
+			} else {
+				assert.Empty(t, rejected)
+			}
+			if tt.wantStatus == http.StatusUnauthorized {
+				assert.ErrorIs(t, rejected[0], errUnauthorized)
+			}
+		})
+	}
+}
+
+// TestListenerSend checks a listener behind a server against what Send
+// delivers, with and without the expected Authorization header.
+func TestListenerSend(t *testing.T) {
+	var events []Event
+	server := httptest.NewServer(&Listener{
+		AuthHeader: "Bearer secret",
+		OnEvent:    func(event Event) { events = append(events, event) },
+	})
+	defer server.Close()
+
+	payload := SamplePayload("library", time.Unix(1718000000, 0))
+	status, err := Send(context.Background(), Target{Address: server.URL, PayloadFormat: FormatCloudEvents, AuthHeader: "Bearer secret"}, payload)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, status)
+
+	status, err = Send(context.Background(), Target{Address: server.URL}, payload)
+	assert.Equal(t, http.StatusUnauthorized, status)
+	assert.ErrorContains(t, err, errUnauthorized.Error())
+
+	require.Len(t, events, 1)
+	assert.Equal(t, payload, events[0].Payload)
+}
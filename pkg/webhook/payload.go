@@ -0,0 +1,104 @@
+package webhook
+
+// Payload is the body Harbor posts to HTTP webhook targets in the Default
+// payload format.
+type Payload struct {
+	Type      string     `json:"type"`
+	OccurAt   int64      `json:"occur_at"`
+	Operator  string     `json:"operator"`
+	EventData *EventData `json:"event_data,omitempty"`
+}
+
+// EventData holds the resources an event is about. Which fields are set
+// depends on the event type.
+type EventData struct {
+	Resources   []*Resource       `json:"resources,omitempty"`
+	Repository  *Repository       `json:"repository,omitempty"`
+	Replication *Replication      `json:"replication,omitempty"`
+	Retention   *Retention        `json:"retention,omitempty"`
+	Custom      map[string]string `json:"custom_attributes,omitempty"`
+}
+
+// Resource is an artifact affected by an event.
+type Resource struct {
+	Digest       string                 `json:"digest,omitempty"`
+	Tag          string                 `json:"tag,omitempty"`
+	ResourceURL  string                 `json:"resource_url,omitempty"`
+	ScanOverview map[string]interface{} `json:"scan_overview,omitempty"`
+}
+
+// Repository is the repository of the resources of an event.
+type Repository struct {
+	DateCreated  int64  `json:"date_created,omitempty"`
+	Name         string `json:"name"`
+	Namespace    string `json:"namespace"`
+	RepoFullName string `json:"repo_full_name"`
+	RepoType     string `json:"repo_type"`
+}
+
+// Replication describes the replication execution of a REPLICATION event.
+type Replication struct {
+	HarborHostname     string                `json:"harbor_hostname,omitempty"`
+	JobStatus          string                `json:"job_status,omitempty"`
+	Description        string                `json:"description,omitempty"`
+	ArtifactType       string                `json:"artifact_type,omitempty"`
+	AuthenticationType string                `json:"authentication_type,omitempty"`
+	OverrideMode       bool                  `json:"override_mode,omitempty"`
+	TriggerType        string                `json:"trigger_type,omitempty"`
+	PolicyCreator      string                `json:"policy_creator,omitempty"`
+	ExecutionTimestamp int64                 `json:"execution_timestamp,omitempty"`
+	SrcResource        *ReplicationResource  `json:"src_resource,omitempty"`
+	DestResource       *ReplicationResource  `json:"dest_resource,omitempty"`
+	SuccessfulArtifact []*ReplicatedArtifact `json:"successful_artifact,omitempty"`
+	FailedArtifact     []*ReplicatedArtifact `json:"failed_artifact,omitempty"`
+}
+
+// ReplicationResource is the source or destination of a replication.
+type ReplicationResource struct {
+	RegistryName string `json:"registry_name,omitempty"`
+	RegistryType string `json:"registry_type"`
+	Endpoint     string `json:"endpoint"`
+	Namespace    string `json:"namespace,omitempty"`
+}
+
+// ReplicatedArtifact is an artifact copied, or not, by a replication.
+type ReplicatedArtifact struct {
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	NameTag string `json:"name_tag"`
+}
+
+// Retention describes the retention execution of a TAG_RETENTION event.
+type Retention struct {
+	Total             int                 `json:"total"`
+	Retained          int                 `json:"retained"`
+	HarborHostname    string              `json:"harbor_hostname,omitempty"`
+	ProjectName       string              `json:"project_name,omitempty"`
+	RetentionPolicyID int64               `json:"retention_policy_id,omitempty"`
+	RetentionRule     []*RetentionRule    `json:"retention_rule,omitempty"`
+	Status            string              `json:"result,omitempty"`
+	DeletedArtifact   []*RetainedArtifact `json:"deleted_artifact,omitempty"`
+}
+
+// RetentionRule is a rule of the retention policy that ran.
+type RetentionRule struct {
+	Template       string                          `json:"template,omitempty"`
+	Parameters     map[string]interface{}          `json:"params,omitempty"`
+	TagSelectors   []*RetentionSelector            `json:"tag_selectors,omitempty"`
+	ScopeSelectors map[string][]*RetentionSelector `json:"scope_selectors,omitempty"`
+}
+
+// RetentionSelector selects the repositories or tags of a retention rule.
+type RetentionSelector struct {
+	Kind       string `json:"kind"`
+	Decoration string `json:"decoration"`
+	Pattern    string `json:"pattern"`
+	Extras     string `json:"extras"`
+}
+
+// RetainedArtifact is an artifact deleted by a retention execution.
+type RetainedArtifact struct {
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	NameTag string `json:"name_tag"`
+}
@@ -0,0 +1,145 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Target types and payload formats of webhook policies.
+const (
+	TargetHTTP  = "http"
+	TargetSlack = "slack"
+
+	FormatDefault     = "Default"
+	FormatCloudEvents = "CloudEvents"
+)
+
+// Target is an endpoint of a webhook policy.
+type Target struct {
+	Address        string
+	Type           string
+	PayloadFormat  string
+	AuthHeader     string
+	SkipCertVerify bool
+}
+
+// SamplePayload returns a PUSH_ARTIFACT event for a repository of project,
+// used to test webhook targets.
+func SamplePayload(project string, now time.Time) Payload {
+	return Payload{
+		Type:     "PUSH_ARTIFACT",
+		OccurAt:  now.Unix(),
+		Operator: "harbor-cli",
+		EventData: &EventData{
+			Resources: []*Resource{{
+				Digest:      "sha256:0000000000000000000000000000000000000000000000000000000000000000",
+				Tag:         "webhook-test",
+				ResourceURL: project + "/webhook-test:webhook-test",
+			}},
+			Repository: &Repository{
+				DateCreated:  now.Unix(),
+				Name:         "webhook-test",
+				Namespace:    project,
+				RepoFullName: project + "/webhook-test",
+				RepoType:     "private",
+			},
+		},
+	}
+}
+
+// Send delivers payload to target the way Harbor would and returns the
+// HTTP status of the response.
+func Send(ctx context.Context, target Target, payload Payload) (int, error) {
+	body, headers, err := encode(target, payload)
+	if err != nil {
+		return 0, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target.Address, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+	if target.AuthHeader != "" {
+		req.Header.Set("Authorization", target.AuthHeader)
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if target.SkipCertVerify {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true} //nolint:gosec // the policy asks to skip verification
+	}
+	client := &http.Client{Transport: transport, Timeout: 30 * time.Second}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return resp.StatusCode, fmt.Errorf("%s responded %s: %s", target.Address, resp.Status, strings.TrimSpace(string(message)))
+	}
+	return resp.StatusCode, nil
+}
+
+func encode(target Target, payload Payload) ([]byte, map[string]string, error) {
+	switch {
+	case target.Type == TargetSlack:
+		data, err := json.MarshalIndent(payload, "", "  ")
+		if err != nil {
+			return nil, nil, err
+		}
+		text := fmt.Sprintf("Harbor webhook event %s\n```%s```", payload.Type, data)
+		body, err := json.Marshal(map[string]string{"text": text})
+		return body, nil, err
+	case target.PayloadFormat == FormatCloudEvents:
+		body, err := json.Marshal(payload.EventData)
+		headers := map[string]string{
+			"ce-specversion": "1.0",
+			"ce-id":          strconv.FormatInt(time.Now().UnixNano(), 10),
+			"ce-source":      "harbor-cli",
+			"ce-type":        CloudEventType(payload.Type),
+			"ce-time":        time.Unix(payload.OccurAt, 0).UTC().Format(time.RFC3339),
+			"ce-operator":    payload.Operator,
+		}
+		return body, headers, err
+	default:
+		body, err := json.Marshal(payload)
+		return body, nil, err
+	}
+}
+
+// cloudEventTypes maps Harbor event types to their CloudEvents types.
+var cloudEventTypes = map[string]string{
+	"PUSH_ARTIFACT":      "harbor.artifact.pushed",
+	"PULL_ARTIFACT":      "harbor.artifact.pulled",
+	"DELETE_ARTIFACT":    "harbor.artifact.deleted",
+	"SCANNING_COMPLETED": "harbor.scan.completed",
+	"SCANNING_FAILED":    "harbor.scan.failed",
+	"SCANNING_STOPPED":   "harbor.scan.stopped",
+	"QUOTA_EXCEED":       "harbor.quota.exceeded",
+	"QUOTA_WARNING":      "harbor.quota.warned",
+	"REPLICATION":        "harbor.replication.status.changed",
+	"TAG_RETENTION":      "harbor.tag_retention.finished",
+}
+
+// CloudEventType maps a Harbor event type such as PUSH_ARTIFACT to its
+// CloudEvents type harbor.artifact.pushed.
+func CloudEventType(eventType string) string {
+	if t, ok := cloudEventTypes[eventType]; ok {
+		return t
+	}
+	return "harbor." + strings.ToLower(eventType)
+}
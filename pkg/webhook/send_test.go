@@ -0,0 +1,118 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncode(t *testing.T) {
+	payload := SamplePayload("library", time.Unix(1718000000, 0))
+
+	tests := []struct {
+		name        string
+		target      Target
+		wantHeaders map[string]string
+		check       func(t *testing.T, body []byte)
+	}{
+		{
+			name:   "default",
+			target: Target{Type: TargetHTTP, PayloadFormat: FormatDefault},
+			check: func(t *testing.T, body []byte) {
+				var decoded Payload
+				require.NoError(t, json.Unmarshal(body, &decoded))
+				assert.Equal(t, payload, decoded)
+			},
+		},
+		{
+			name:   "CloudEvents",
+			target: Target{Type: TargetHTTP, PayloadFormat: FormatCloudEvents},
+			wantHeaders: map[string]string{
+				"ce-specversion": "1.0",
+				"ce-source":      "harbor-cli",
+				"ce-type":        "harbor.artifact.pushed",
+				"ce-time":        "2024-06-10T06:13:20Z",
+				"ce-operator":    "harbor-cli",
+			},
+			check: func(t *testing.T, body []byte) {
+				var data EventData
+				require.NoError(t, json.Unmarshal(body, &data))
+				assert.Equal(t, *payload.EventData, data)
+			},
+		},
+		{
+			name:   "slack",
+			target: Target{Type: TargetSlack, PayloadFormat: FormatCloudEvents},
+			check: func(t *testing.T, body []byte) {
+				var message map[string]string
+				require.NoError(t, json.Unmarshal(body, &message))
+				assert.Contains(t, message["text"], "Harbor webhook event PUSH_ARTIFACT\n```{")
+				assert.Contains(t, message["text"], `"repo_full_name": "library/webhook-test"`)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, headers, err := encode(tt.target, payload)
+			require.NoError(t, err)
+			for name, value := range tt.wantHeaders {
+				assert.Equal(t, value, headers[name], name)
+			}
+			if tt.wantHeaders == nil {
+				assert.Empty(t, headers)
+			} else {
+				assert.NotEmpty(t, headers["ce-id"])
+			}
+			tt.check(t, body)
+		})
+	}
+}
+
+// TestSendDecode checks that what Send delivers decodes to the same event
+// in both payload formats.
+func TestSendDecode(t *testing.T) {
+	payload := SamplePayload("library", time.Unix(1718000000, 0))
+
+	for _, format := range []string{FormatDefault, FormatCloudEvents} {
+		t.Run(format, func(t *testing.T) {
+			var received Payload
+			var authorization string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				authorization = r.Header.Get("Authorization")
+				var err error
+				received, err = Decode(r.Header, body)
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+				}
+			}))
+			defer server.Close()
+
+			target := Target{Address: server.URL, Type: TargetHTTP, PayloadFormat: format, AuthHeader: "Bearer secret"}
+			status, err := Send(context.Background(), target, payload)
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusOK, status)
+			assert.Equal(t, "Bearer secret", authorization)
+			assert.Equal(t, payload, received)
+		})
+	}
+}
+
+func TestSendError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unknown hook", http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	status, err := Send(context.Background(), Target{Address: server.URL}, SamplePayload("library", time.Now()))
+	assert.Equal(t, http.StatusNotFound, status)
+	assert.ErrorContains(t, err, "404 Not Found: unknown hook")
+}